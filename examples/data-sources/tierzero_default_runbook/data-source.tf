@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    tierzero = {
+      source = "tierzeroai/tierzero"
+    }
+  }
+}
+
+provider "tierzero" {
+  # API key from TIERZERO_API_KEY environment variable
+  # base_url defaults to https://api.tierzero.ai
+}
+
+# Fetch the organization's default runbook
+data "tierzero_default_runbook" "default" {}
+
+# Extend the default investigation prompt; the impact and severity prompt,
+# left unset, keeps following the default
+resource "tierzero_alert_responder" "example" {
+  team_name = "Production"
+  name      = "Checkout Errors"
+
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["checkout", "error"]
+  }
+
+  runbook = {
+    investigation_prompt = <<-EOT
+      ${data.tierzero_default_runbook.default.investigation_prompt}
+
+      Also check the payment provider status page and the latest checkout deploys.
+    EOT
+  }
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    tierzero = {
+      source = "tierzeroai/tierzero"
+    }
+  }
+}
+
+provider "tierzero" {
+  # API key from TIERZERO_API_KEY environment variable
+}
+
+# Fetch all deleted alert responders
+data "tierzero_deleted_alert_responders" "all" {}
+
+# Fetch deleted alert responders of a team by name prefix
+data "tierzero_deleted_alert_responders" "platform" {
+  team_name   = "Platform"
+  name_prefix = "Production"
+}
+
+# Output deleted alert responders
+output "deleted_alert_responders" {
+  value = data.tierzero_deleted_alert_responders.all.alert_responders
+}
+
+# Restore a deleted alert responder holding the name instead of failing
+resource "tierzero_alert_responder" "restored" {
+  team_name          = "Platform"
+  name               = "Production Alerts"
+  restore_if_deleted = true
+
+  webhook_sources = [{
+    type      = "PAGERDUTY"
+    remote_id = "PXXXXXX"
+  }]
+
+  matching_criteria = {
+    text_matches = ["critical"]
+  }
+}
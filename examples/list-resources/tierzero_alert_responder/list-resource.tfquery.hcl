@@ -0,0 +1,15 @@
+# List every alert responder in the organization
+list "tierzero_alert_responder" "all" {
+  provider = tierzero
+}
+
+# List the paused Slack alert responders of one team
+list "tierzero_alert_responder" "platform_slack" {
+  provider = tierzero
+
+  config {
+    team_name   = "Platform"
+    enabled     = false
+    source_type = "SLACK"
+  }
+}
@@ -12,4 +12,19 @@ provider "tierzero" {
 
   # Base URL defaults to https://api.tierzero.ai
   # base_url = "https://api.tierzero.ai"
+
+  # Transient API failures (HTTP 429/5xx) are retried with exponential backoff
+  # max_retries    = 3
+  # retry_max_wait = 30
+
+  # Client-side throttling shared by all resources and data sources
+  # requests_per_second     = 10
+  # max_concurrent_requests = 10
+
+  # Seconds before a single API request is abandoned; each retry gets its own
+  # request_timeout = 30
+
+  # Let Terraform delete alert responders despite their deletion_protection,
+  # e.g. in ephemeral test environments
+  # deletion_protection = false
 }
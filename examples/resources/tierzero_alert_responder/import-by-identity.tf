@@ -0,0 +1,16 @@
+# Import an existing alert responder by team name and name (Terraform 1.12+)
+import {
+  to = tierzero_alert_responder.production_critical
+  identity = {
+    team_name = "Platform"
+    name      = "Production Alerts"
+  }
+}
+
+# Or by its Global ID
+import {
+  to = tierzero_alert_responder.production_critical
+  identity = {
+    id = "R3JhcGhRTEFsZXJ0OjEyMw=="
+  }
+}
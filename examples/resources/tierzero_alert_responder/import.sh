@@ -1,3 +1,6 @@
 #!/bin/bash
-# Import an existing alert responder by its Global ID
+# Import an existing alert responder by team name and name
+terraform import tierzero_alert_responder.production_critical "Platform/Production Alerts"
+
+# Or by its Global ID
 terraform import tierzero_alert_responder.production_critical "R3JhcGhRTEFsZXJ0OjEyMw=="
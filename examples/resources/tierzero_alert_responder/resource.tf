@@ -43,7 +43,7 @@ resource "tierzero_alert_responder" "slack_database_alerts" {
 }
 
 # Slack alert responder with bot filter
-# Runbook is optional - if not specified, uses the default investigation prompt
+# Runbook is optional - if not specified, uses the organization's default runbook
 resource "tierzero_alert_responder" "slack_datadog_alerts" {
   team_name = "Default"
   name      = "Slack Datadog Alerts"
@@ -4,7 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
 )
 
 // AlertResponder represents an alert responder resource
@@ -23,6 +29,7 @@ type AlertResponder struct {
 	CreatedAt                  string                 `json:"created_at,omitempty"`
 	UpdatedAt                  string                 `json:"updated_at,omitempty"`
 	URL                        string                 `json:"url,omitempty"`    // Returned by: Create, Update, List (not by Get, Enable, Disable)
+	DeletedAt                  string                 `json:"deleted_at,omitempty"` // Returned by ListDeletedAlertResponders only
 }
 
 // Runbook contains investigation prompts
@@ -52,9 +59,31 @@ type CreateAlertResponderRequest struct {
 	MatchingCriteria           *MatchingCriteria     `json:"matching_criteria"`
 	Runbook                    *Runbook              `json:"runbook,omitempty"`
 	NotificationIntegrationIDs []string              `json:"notification_integration_ids,omitempty"`
+
+	// Status is the initial status, ACTIVE or PAUSED. The API creates ACTIVE
+	// responders when it is empty.
+	Status string `json:"status,omitempty"`
+
+	// IdempotencyKey is sent in the Idempotency-Key header. Repeating a create
+	// with the same key returns the responder created by the first request,
+	// while a duplicate name created under another key fails with 409 Conflict.
+	// A random key is generated when empty.
+	IdempotencyKey string `json:"-"`
+}
+
+// NewIdempotencyKey returns a random key for CreateAlertResponderRequest.IdempotencyKey
+func NewIdempotencyKey() (string, error) {
+	key, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	return key, nil
 }
 
-// UpdateAlertResponderRequest is the request body for updating an alert responder
+// UpdateAlertResponderRequest is the request body for updating an alert responder.
+// The API applies updates with JSON Merge Patch (RFC 7396) semantics: omitted
+// fields are left unchanged and fields sent as null are removed. Use Clear to
+// remove optional settings, since empty values are omitted.
 type UpdateAlertResponderRequest struct {
 	Name                       *string               `json:"name,omitempty"`
 	MatchingCriteria           *MatchingCriteria     `json:"matching_criteria,omitempty"`
@@ -62,16 +91,78 @@ type UpdateAlertResponderRequest struct {
 	SlackChannelID             *string               `json:"slack_channel_id,omitempty"`
 	Runbook                    *Runbook              `json:"runbook,omitempty"`
 	NotificationIntegrationIDs []string              `json:"notification_integration_ids,omitempty"`
+
+	// Clear lists the fields to remove, as dot-separated JSON paths (see the
+	// Field* constants). They are sent as explicit nulls.
+	Clear []string `json:"-"`
+}
+
+// Optional fields that can be removed with UpdateAlertResponderRequest.Clear
+const (
+	FieldRunbook                    = "runbook"
+	FieldRunbookInvestigationPrompt = "runbook.investigation_prompt"
+	FieldRunbookImpactAndSeverity   = "runbook.impact_and_severity_prompt"
+	FieldNotificationIntegrationIDs = "notification_integration_ids"
+	FieldSlackBotAppUserID          = "matching_criteria.slack_bot_app_user_id"
+)
+
+// MarshalJSON encodes the request as a merge patch, adding an explicit null
+// for every field listed in Clear
+func (r UpdateAlertResponderRequest) MarshalJSON() ([]byte, error) {
+	type plain UpdateAlertResponderRequest
+	data, err := json.Marshal(plain(r))
+	if err != nil || len(r.Clear) == 0 {
+		return data, err
+	}
+
+	var patch map[string]interface{}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	for _, field := range r.Clear {
+		setNull(patch, strings.Split(field, "."))
+	}
+
+	return json.Marshal(patch)
+}
+
+// setNull sets the value at the given path to null, creating intermediate
+// objects as needed. A null parent already removes everything below it.
+func setNull(patch map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		patch[path[0]] = nil
+		return
+	}
+
+	value, exists := patch[path[0]]
+	if exists && value == nil {
+		return
+	}
+	child, ok := value.(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		patch[path[0]] = child
+	}
+	setNull(child, path[1:])
 }
 
 // ListAlertRespondersResponse is the response from listing alert responders
 type ListAlertRespondersResponse struct {
 	AlertResponders []AlertResponder `json:"alert_responders"`
+	NextCursor      string           `json:"next_cursor,omitempty"` // Empty on the last page
 }
 
 // CreateAlertResponder creates a new alert responder
 func (c *Client) CreateAlertResponder(ctx context.Context, req *CreateAlertResponderRequest) (*AlertResponder, error) {
-	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/alert-responders", req)
+	key := req.IdempotencyKey
+	if key == "" {
+		var err error
+		if key, err = NewIdempotencyKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/alert-responders", req, withIdempotencyKey(key))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create alert responder: %w", err)
 	}
@@ -100,11 +191,51 @@ func (c *Client) GetAlertResponder(ctx context.Context, id string) (*AlertRespon
 	return &alertResponder, nil
 }
 
-// ListAlertResponders lists all alert responders, optionally filtered by team
-func (c *Client) ListAlertResponders(ctx context.Context, teamName *string) ([]AlertResponder, error) {
+// ListAlertRespondersOptions filters and paginates ListAlertResponders.
+// Zero values are not sent.
+type ListAlertRespondersOptions struct {
+	TeamName   string // Exact team name
+	Status     string // ACTIVE or PAUSED
+	NamePrefix string // Case-sensitive prefix of the responder name
+	SourceType string // PAGERDUTY, OPSGENIE, FIREHYDRANT, ROOTLY or SLACK
+	PageSize   int    // Responders per page; the server default applies when zero
+	Cursor     string // Cursor returned as NextCursor by the previous page
+}
+
+// values encodes the options as query parameters
+func (o *ListAlertRespondersOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.TeamName != "" {
+		v.Set("team_name", o.TeamName)
+	}
+	if o.Status != "" {
+		v.Set("status", o.Status)
+	}
+	if o.NamePrefix != "" {
+		v.Set("name_prefix", o.NamePrefix)
+	}
+	if o.SourceType != "" {
+		v.Set("source_type", o.SourceType)
+	}
+	if o.PageSize > 0 {
+		v.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.Cursor != "" {
+		v.Set("cursor", o.Cursor)
+	}
+	return v
+}
+
+// ListAlertRespondersPage fetches a single page of alert responders. Pass the
+// returned NextCursor as opts.Cursor to fetch the following page; an empty
+// NextCursor means this was the last page.
+func (c *Client) ListAlertRespondersPage(ctx context.Context, opts *ListAlertRespondersOptions) (*ListAlertRespondersResponse, error) {
 	path := "/api/v1/alert-responders"
-	if teamName != nil && *teamName != "" {
-		path = fmt.Sprintf("%s?team_name=%s", path, *teamName)
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
 	}
 
 	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
@@ -117,7 +248,137 @@ func (c *Client) ListAlertResponders(ctx context.Context, teamName *string) ([]A
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return response.AlertResponders, nil
+	return &response, nil
+}
+
+// AlertResponders iterates over every alert responder matching opts,
+// fetching pages on demand. Iteration stops at the first error, which is
+// yielded with a zero AlertResponder. opts.Cursor sets the starting page.
+func (c *Client) AlertResponders(ctx context.Context, opts *ListAlertRespondersOptions) iter.Seq2[AlertResponder, error] {
+	pageOpts := ListAlertRespondersOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return paginateAlertResponders("list alert responders", &pageOpts.Cursor, func() (*ListAlertRespondersResponse, error) {
+		return c.ListAlertRespondersPage(ctx, &pageOpts)
+	})
+}
+
+// paginateAlertResponders yields the alert responders of every page returned
+// by fetchPage, setting *cursor to the next page's cursor between calls
+func paginateAlertResponders(operation string, cursor *string, fetchPage func() (*ListAlertRespondersResponse, error)) iter.Seq2[AlertResponder, error] {
+	return func(yield func(AlertResponder, error) bool) {
+		for {
+			page, err := fetchPage()
+			if err != nil {
+				yield(AlertResponder{}, err)
+				return
+			}
+
+			for _, alertResponder := range page.AlertResponders {
+				if !yield(alertResponder, nil) {
+					return
+				}
+			}
+
+			if page.NextCursor == "" {
+				return
+			}
+			if page.NextCursor == *cursor {
+				yield(AlertResponder{}, fmt.Errorf("failed to %s: API returned the same page cursor %q twice", operation, page.NextCursor))
+				return
+			}
+			*cursor = page.NextCursor
+		}
+	}
+}
+
+// ListAlertResponders lists all alert responders matching opts, following
+// pagination until the last page. opts may be nil to list every responder.
+func (c *Client) ListAlertResponders(ctx context.Context, opts *ListAlertRespondersOptions) ([]AlertResponder, error) {
+	var alertResponders []AlertResponder
+	for alertResponder, err := range c.AlertResponders(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		alertResponders = append(alertResponders, alertResponder)
+	}
+
+	return alertResponders, nil
+}
+
+// ListDeletedAlertRespondersOptions filters and paginates
+// ListDeletedAlertResponders. Zero values are not sent.
+type ListDeletedAlertRespondersOptions struct {
+	TeamName   string // Exact team name
+	NamePrefix string // Case-sensitive prefix of the responder name
+	PageSize   int    // Responders per page; the server default applies when zero
+	Cursor     string // Cursor returned as NextCursor by the previous page
+}
+
+// values encodes the options as query parameters
+func (o *ListDeletedAlertRespondersOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.TeamName != "" {
+		v.Set("team_name", o.TeamName)
+	}
+	if o.NamePrefix != "" {
+		v.Set("name_prefix", o.NamePrefix)
+	}
+	if o.PageSize > 0 {
+		v.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.Cursor != "" {
+		v.Set("cursor", o.Cursor)
+	}
+	return v
+}
+
+// ListDeletedAlertRespondersPage fetches a single page of soft-deleted alert
+// responders, which can be restored. Purged responders are not listed.
+func (c *Client) ListDeletedAlertRespondersPage(ctx context.Context, opts *ListDeletedAlertRespondersOptions) (*ListAlertRespondersResponse, error) {
+	path := "/api/v1/deleted-alert-responders"
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
+	}
+
+	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted alert responders: %w", err)
+	}
+
+	var response ListAlertRespondersResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// ListDeletedAlertResponders lists all soft-deleted alert responders matching
+// opts, following pagination until the last page. opts may be nil.
+func (c *Client) ListDeletedAlertResponders(ctx context.Context, opts *ListDeletedAlertRespondersOptions) ([]AlertResponder, error) {
+	pageOpts := ListDeletedAlertRespondersOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	var alertResponders []AlertResponder
+	pages := paginateAlertResponders("list deleted alert responders", &pageOpts.Cursor, func() (*ListAlertRespondersResponse, error) {
+		return c.ListDeletedAlertRespondersPage(ctx, &pageOpts)
+	})
+	for alertResponder, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		alertResponders = append(alertResponders, alertResponder)
+	}
+
+	return alertResponders, nil
 }
 
 // UpdateAlertResponder updates an existing alert responder
@@ -136,7 +397,8 @@ func (c *Client) UpdateAlertResponder(ctx context.Context, id string, req *Updat
 	return &alertResponder, nil
 }
 
-// DeleteAlertResponder deletes an alert responder (soft delete)
+// DeleteAlertResponder deletes an alert responder (soft delete). Use
+// PurgeAlertResponder to delete it permanently.
 func (c *Client) DeleteAlertResponder(ctx context.Context, id string) error {
 	path := fmt.Sprintf("/api/v1/alert-responders/%s", id)
 	_, err := c.doRequest(ctx, http.MethodDelete, path, nil)
@@ -147,10 +409,41 @@ func (c *Client) DeleteAlertResponder(ctx context.Context, id string) error {
 	return nil
 }
 
+// PurgeAlertResponder permanently deletes an alert responder, live or soft
+// deleted, freeing its name. A purged responder cannot be restored.
+func (c *Client) PurgeAlertResponder(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/api/v1/alert-responders/%s?purge=true", id)
+	_, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to purge alert responder: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreAlertResponder restores a soft-deleted alert responder with its
+// previous status
+func (c *Client) RestoreAlertResponder(ctx context.Context, id string) (*AlertResponder, error) {
+	path := fmt.Sprintf("/api/v1/alert-responders/%s/restore", id)
+	// Restoring a responder that is not deleted is a no-op, so retries are safe
+	respBody, err := c.doRequest(ctx, http.MethodPost, path, nil, withRetrySafe())
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore alert responder: %w", err)
+	}
+
+	var alertResponder AlertResponder
+	if err := json.Unmarshal(respBody, &alertResponder); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &alertResponder, nil
+}
+
 // EnableAlertResponder enables an alert responder
 func (c *Client) EnableAlertResponder(ctx context.Context, id string) (*AlertResponder, error) {
 	path := fmt.Sprintf("/api/v1/alert-responders/%s/enable", id)
-	respBody, err := c.doRequest(ctx, http.MethodPost, path, nil)
+	// Enabling an already active responder is a no-op, so retries are safe
+	respBody, err := c.doRequest(ctx, http.MethodPost, path, nil, withRetrySafe())
 	if err != nil {
 		return nil, fmt.Errorf("failed to enable alert responder: %w", err)
 	}
@@ -166,7 +459,8 @@ func (c *Client) EnableAlertResponder(ctx context.Context, id string) (*AlertRes
 // DisableAlertResponder disables an alert responder
 func (c *Client) DisableAlertResponder(ctx context.Context, id string) (*AlertResponder, error) {
 	path := fmt.Sprintf("/api/v1/alert-responders/%s/disable", id)
-	respBody, err := c.doRequest(ctx, http.MethodPost, path, nil)
+	// Disabling an already paused responder is a no-op, so retries are safe
+	respBody, err := c.doRequest(ctx, http.MethodPost, path, nil, withRetrySafe())
 	if err != nil {
 		return nil, fmt.Errorf("failed to disable alert responder: %w", err)
 	}
@@ -0,0 +1,240 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+func TestListAlertRespondersPaginatesAndFilters(t *testing.T) {
+	server := tierzerotest.NewServer(t, tierzerotest.WithPageSize(2))
+	for i := range 5 {
+		server.PutAlertResponder(client.AlertResponder{TeamName: "Search & Ranking", Name: fmt.Sprintf("prod-%d", i)})
+	}
+	server.PutAlertResponder(client.AlertResponder{TeamName: "Search & Ranking", Name: "staging"})
+	server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "prod-other-team"})
+	c := server.Client()
+
+	got, err := c.ListAlertResponders(context.Background(), &client.ListAlertRespondersOptions{
+		TeamName:   "Search & Ranking",
+		NamePrefix: "prod-",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != 5 {
+		t.Fatalf("expected 5 alert responders, got %d", len(got))
+	}
+	for i, ar := range got {
+		if want := fmt.Sprintf("prod-%d", i); ar.Name != want {
+			t.Errorf("alert responder %d: expected name %q, got %q", i, want, ar.Name)
+		}
+	}
+
+	// 3 pages of 2, 2 and 1 responders
+	if requests := server.Requests(); len(requests) != 3 {
+		t.Errorf("expected 3 page requests, got %d", len(requests))
+	}
+}
+
+func TestAlertRespondersIteratorStopsEarly(t *testing.T) {
+	server := tierzerotest.NewServer(t, tierzerotest.WithPageSize(1))
+	for i := range 3 {
+		server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: fmt.Sprintf("responder-%d", i)})
+	}
+	c := server.Client()
+
+	for ar, err := range c.AlertResponders(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if ar.Name != "responder-0" {
+			t.Errorf("unexpected alert responder %q", ar.Name)
+		}
+		break
+	}
+	if requests := server.Requests(); len(requests) != 1 {
+		t.Errorf("expected only the first page to be fetched, got %d requests", len(requests))
+	}
+}
+
+func TestUpdateAlertResponderClearsFields(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	integration := server.AddNotificationIntegration(client.NotificationIntegration{Name: "Slack", Kind: "SLACK_ALERT"})
+	ar := server.PutAlertResponder(client.AlertResponder{
+		TeamName:       "Platform",
+		Name:           "Clearable",
+		SlackChannelID: stringPtr("C123"),
+		MatchingCriteria: &client.MatchingCriteria{
+			TextMatches:       []string{"error"},
+			SlackBotAppUserID: stringPtr("B123"),
+		},
+		Runbook:                    &client.Runbook{InvestigationPrompt: "investigate"},
+		NotificationIntegrationIDs: []string{integration.ID},
+	})
+	c := server.Client()
+
+	_, err := c.UpdateAlertResponder(context.Background(), ar.ID, &client.UpdateAlertResponderRequest{
+		Clear: []string{client.FieldRunbook, client.FieldNotificationIntegrationIDs, client.FieldSlackBotAppUserID},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var body map[string]json.RawMessage
+	requests := server.Requests()
+	if err := json.Unmarshal(requests[len(requests)-1].Body, &body); err != nil {
+		t.Fatalf("invalid request body: %s", err)
+	}
+	if string(body["runbook"]) != "null" || string(body["notification_integration_ids"]) != "null" {
+		t.Errorf("expected explicit nulls, got %s", requests[len(requests)-1].Body)
+	}
+
+	got, ok := server.AlertResponder(ar.ID)
+	if !ok {
+		t.Fatal("alert responder disappeared")
+	}
+	if got.Runbook != nil || len(got.NotificationIntegrationIDs) != 0 || got.MatchingCriteria.SlackBotAppUserID != nil {
+		t.Errorf("expected fields to be cleared, got %+v", got)
+	}
+	if len(got.MatchingCriteria.TextMatches) != 1 {
+		t.Errorf("expected text matches to be kept, got %v", got.MatchingCriteria.TextMatches)
+	}
+}
+
+func TestAlertResponderURLAndSoftDelete(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	ar := server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "No URL"})
+	c := server.Client()
+
+	got, err := c.GetAlertResponder(context.Background(), ar.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.URL != "" {
+		t.Errorf("expected GET to omit the URL, got %q", got.URL)
+	}
+
+	if err := c.DeleteAlertResponder(context.Background(), ar.ID); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !server.IsDeleted(ar.ID) {
+		t.Error("expected the alert responder to be soft deleted")
+	}
+	if _, err := c.GetAlertResponder(context.Background(), ar.ID); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error after delete, got: %v", err)
+	}
+
+	server.FailNext(http.MethodGet, "", http.StatusNotFound, 1)
+	if _, err := c.ListWebhookSubscriptions(context.Background()); !client.IsNotFound(err) {
+		t.Errorf("expected injected not found error, got: %v", err)
+	}
+}
+
+func TestRestoreAndPurgeDeletedAlertResponders(t *testing.T) {
+	server := tierzerotest.NewServer(t, tierzerotest.WithPageSize(1))
+	restored := server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "Restored", Status: "PAUSED"})
+	purged := server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "Purged"})
+	server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "Live"})
+	c := server.Client()
+	ctx := context.Background()
+
+	for _, id := range []string{restored.ID, purged.ID} {
+		if err := c.DeleteAlertResponder(ctx, id); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	deleted, err := c.ListDeletedAlertResponders(ctx, &client.ListDeletedAlertRespondersOptions{TeamName: "Platform"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(deleted) != 2 || deleted[0].ID != restored.ID || deleted[1].ID != purged.ID {
+		t.Fatalf("expected the 2 deleted alert responders, got %+v", deleted)
+	}
+	if deleted[0].DeletedAt == "" {
+		t.Error("expected deleted_at to be set")
+	}
+
+	got, err := c.RestoreAlertResponder(ctx, restored.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.Status != "PAUSED" {
+		t.Errorf("expected the previous status to be kept, got %q", got.Status)
+	}
+	if _, ok := server.AlertResponder(restored.ID); !ok {
+		t.Error("expected the alert responder to be live again")
+	}
+
+	// A soft-deleted responder can be purged, which frees its name
+	if err := c.PurgeAlertResponder(ctx, purged.ID); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if server.IsDeleted(purged.ID) {
+		t.Error("expected the alert responder to be purged")
+	}
+	if _, err := c.RestoreAlertResponder(ctx, purged.ID); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error restoring a purged responder, got: %v", err)
+	}
+	_, err = c.CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+		TeamName:         "Platform",
+		Name:             "Purged",
+		SlackChannelID:   stringPtr("C123"),
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+	})
+	if err != nil {
+		t.Errorf("expected the purged responder's name to be free, got: %s", err)
+	}
+
+	deleted, err = c.ListDeletedAlertResponders(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("expected no deleted alert responders left, got %+v", deleted)
+	}
+}
+
+// recordingHandler records the body of every request and answers with an
+// alert responder
+func recordingHandler(bodies *[][]byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*bodies = append(*bodies, body)
+		_, _ = w.Write([]byte(`{"id":"ar-1","team_name":"Platform","name":"Clearable"}`))
+	}
+}
+
+func TestUpdateAlertResponderClearsNestedFieldUnderClearedParent(t *testing.T) {
+	var bodies [][]byte
+	c := newTestClient(t, recordingHandler(&bodies))
+
+	_, err := c.UpdateAlertResponder(context.Background(), "ar-1", &client.UpdateAlertResponderRequest{
+		Clear: []string{client.FieldRunbook, client.FieldRunbookInvestigationPrompt},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A null runbook already removes its prompts
+	if got := string(bodies[0]); got != `{"runbook":null}` {
+		t.Errorf("expected only the runbook to be cleared, got %s", got)
+	}
+}
+
+func TestListAlertRespondersStopsOnRepeatedCursor(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"alert_responders":[{"name":"looping"}],"next_cursor":"same"}`))
+	})
+
+	if _, err := c.ListAlertResponders(context.Background(), nil); err == nil {
+		t.Fatal("expected an error when the API repeats a cursor")
+	}
+}
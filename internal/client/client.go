@@ -6,13 +6,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
 )
 
 const (
-	defaultTimeout = 30 * time.Second
-	apiKeyHeader   = "X-TierZero-Org-Api-Key"
+	defaultRequestTimeout = 30 * time.Second
+	defaultMaxRetries     = 3
+	defaultRetryWaitMin   = 1 * time.Second
+	defaultRetryWaitMax   = 30 * time.Second
+	apiKeyHeader          = "X-TierZero-Org-Api-Key"
+
+	idempotencyKeyHeader = "Idempotency-Key"
 )
 
 // Client is the HTTP client for the TierZero API
@@ -21,81 +31,255 @@ type Client struct {
 	APIKey     string
 	UserAgent  string
 	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a request is retried after a
+	// transient failure (network error, 429 or 5xx). Zero disables retries.
+	MaxRetries int
+	// RetryWaitMin is the base delay used for exponential backoff.
+	RetryWaitMin time.Duration
+	// RetryWaitMax caps the delay between two attempts, including delays
+	// requested by the server through the Retry-After header.
+	RetryWaitMax time.Duration
+
+	// limiter and inflight throttle requests shared by every resource and
+	// data source. See SetRateLimit and SetMaxConcurrentRequests.
+	limiter  *rate.Limiter
+	inflight chan struct{}
+
+	// har records API exchanges when enabled with EnableHARRecording
+	har *harRecorder
 }
 
 // NewClient creates a new TierZero API client
 func NewClient(baseURL, apiKey string) *Client {
-	return &Client{
+	c := &Client{
 		BaseURL: baseURL,
 		APIKey:  apiKey,
 		HTTPClient: &http.Client{
-			Timeout: defaultTimeout,
+			Timeout: defaultRequestTimeout,
 		},
+		MaxRetries:   defaultMaxRetries,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
 	}
+	c.SetRateLimit(defaultRequestsPerSecond)
+	c.SetMaxConcurrentRequests(defaultMaxConcurrentRequests)
+	return c
 }
 
-// doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+// requestConfig holds per-request settings for doRequest
+type requestConfig struct {
+	// retrySafe marks a request that may be resent after a network error or
+	// 5xx response. GET, PUT, DELETE, HEAD and OPTIONS are always retry-safe.
+	retrySafe bool
+	// idempotencyKey is sent in the Idempotency-Key header on every attempt
+	idempotencyKey string
+}
+
+// requestOption customizes a single call to doRequest
+type requestOption func(*requestConfig)
+
+// withRetrySafe marks a non-idempotent request (e.g. POST) as safe to retry
+func withRetrySafe() requestOption {
+	return func(cfg *requestConfig) {
+		cfg.retrySafe = true
+	}
+}
+
+// withIdempotencyKey sends the given Idempotency-Key header. The API
+// processes a key at most once, so the request becomes safe to retry.
+func withIdempotencyKey(key string) requestOption {
+	return func(cfg *requestConfig) {
+		cfg.idempotencyKey = key
+		cfg.retrySafe = true
+	}
+}
+
+// doRequest performs an HTTP request with authentication, retrying
+// transient failures with jittered exponential backoff
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, opts ...requestOption) ([]byte, error) {
+	cfg := requestConfig{
+		retrySafe: isIdempotentMethod(method),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var jsonData []byte
 	if body != nil {
-		jsonData, err := json.Marshal(body)
+		var err error
+		jsonData, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonData)
+	}
+
+	ctx = c.loggingContext(ctx)
+
+	for attempt := 0; ; attempt++ {
+		respBody, resp, err := c.send(ctx, method, path, jsonData, &cfg)
+
+		retry := false
+		if err != nil {
+			retry = cfg.retrySafe && ctx.Err() == nil
+		} else {
+			retry = shouldRetryStatus(resp.StatusCode, cfg.retrySafe)
+		}
+
+		if !retry || attempt >= c.MaxRetries {
+			if err != nil {
+				return nil, err
+			}
+			if resp.StatusCode >= 400 {
+				return nil, newAPIError(resp, respBody)
+			}
+			return respBody, nil
+		}
+
+		wait := c.backoff(attempt, resp)
+		fields := map[string]interface{}{
+			"http_method": method,
+			"http_path":   path,
+			"attempt":     attempt + 1,
+			"wait_ms":     wait.Milliseconds(),
+		}
+		if resp != nil {
+			fields["http_status"] = resp.StatusCode
+		}
+		tflog.Debug(ctx, "Retrying TierZero API request", fields)
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+	}
+}
+
+// send performs a single HTTP round trip and returns the response body
+func (c *Client) send(ctx context.Context, method, path string, jsonData []byte, cfg *requestConfig) ([]byte, *http.Response, error) {
+	var reqBody io.Reader
+	if jsonData != nil {
+		reqBody = bytes.NewReader(jsonData)
 	}
 
 	url := c.BaseURL + path
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
 	req.Header.Set(apiKeyHeader, c.APIKey)
-	if body != nil {
+	if jsonData != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
+	if cfg.idempotencyKey != "" {
+		req.Header.Set(idempotencyKeyHeader, cfg.idempotencyKey)
+	}
+
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	c.logRequest(ctx, req, jsonData)
+	started := time.Now()
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		c.logResponse(ctx, req, nil, nil, time.Since(started), err)
+		c.recordHAR(req, jsonData, nil, nil, started, time.Since(started), err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
+	latency := time.Since(started)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		c.logResponse(ctx, req, nil, nil, latency, err)
+		c.recordHAR(req, jsonData, nil, nil, started, latency, err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	// Handle error responses
-	if resp.StatusCode >= 400 {
-		return nil, &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(respBody),
+	c.logResponse(ctx, req, resp, respBody, latency, nil)
+	c.recordHAR(req, jsonData, resp, respBody, started, latency, nil)
+
+	return respBody, resp, nil
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header on the response takes precedence over the computed delay.
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, c.RetryWaitMax)
 		}
 	}
 
-	return respBody, nil
+	// Full jitter: a random delay between zero and min * 2^attempt
+	wait := c.RetryWaitMin << attempt
+	if wait <= 0 || wait > c.RetryWaitMax {
+		wait = c.RetryWaitMax
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return rand.N(wait) + 1
 }
 
-// APIError represents an error response from the API
-type APIError struct {
-	StatusCode int
-	Message    string
+// isIdempotentMethod reports whether a request with the given method can be
+// safely resent
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
 }
 
-func (e *APIError) Error() string {
-	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+// shouldRetryStatus reports whether a response status code is transient.
+// 429 responses are always retried since the server rejected the request
+// before processing it; 5xx responses only when the request is retry-safe.
+func shouldRetryStatus(statusCode int, retrySafe bool) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return retrySafe
+	}
+	return false
 }
 
-// IsNotFound returns true if the error is a 404 Not Found
-func IsNotFound(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusNotFound
+// parseRetryAfter parses a Retry-After header given either as a number of
+// seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	return false
 }
@@ -0,0 +1,251 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+// newTestClient returns a client for a test server running handler, with
+// short retry delays
+func newTestClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := client.NewClient(server.URL, "test-api-key")
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = 10 * time.Millisecond
+	return c
+}
+
+func TestDoRequestRetriesTransientFailures(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	server.FailNext(http.MethodGet, "/api/v1/webhook-subscriptions", http.StatusServiceUnavailable, 2)
+	c := server.Client()
+
+	if _, err := c.ListWebhookSubscriptions(context.Background()); err != nil {
+		t.Fatalf("expected retries to succeed, got: %s", err)
+	}
+	if got := len(server.Requests()); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestDoRequestHonorsRetryAfter(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"webhook_subscriptions":[]}`))
+	})
+	// Retry-After takes precedence over the computed backoff
+	c.RetryWaitMin = time.Hour
+	c.RetryWaitMax = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := c.ListWebhookSubscriptions(ctx); err != nil {
+		t.Fatalf("expected retry after 429 to succeed, got: %s", err)
+	}
+}
+
+func TestDoRequestGivesUpAfterMaxRetries(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	server.FailNext(http.MethodGet, "", http.StatusInternalServerError, 0)
+	c := server.Client()
+	c.MaxRetries = 2
+
+	_, err := c.ListWebhookSubscriptions(context.Background())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := len(server.Requests()); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestDoRequestRetriesRetrySafePost(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	ar := server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "Existing"})
+	server.FailNext(http.MethodPost, "/api/v1/alert-responders/*", http.StatusInternalServerError, 1)
+	c := server.Client()
+
+	got, err := c.DisableAlertResponder(context.Background(), ar.ID)
+	if err != nil {
+		t.Fatalf("expected disable to be retried, got: %s", err)
+	}
+	if got.Status != "PAUSED" {
+		t.Errorf("expected status PAUSED, got %q", got.Status)
+	}
+}
+
+func TestCreateAlertResponderRetryReusesIdempotencyKey(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	// The first create is processed but its response is lost
+	server.InjectFault(tierzerotest.Fault{
+		Method:          http.MethodPost,
+		Path:            "/api/v1/alert-responders",
+		StatusCode:      http.StatusBadGateway,
+		Times:           1,
+		AfterProcessing: true,
+	})
+	c := server.Client()
+
+	ar, err := c.CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{
+		TeamName:         "Platform",
+		Name:             "Retried",
+		SlackChannelID:   stringPtr("C123"),
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+	})
+	if err != nil {
+		t.Fatalf("expected create to be retried, got: %s", err)
+	}
+	if got := len(server.AlertResponders()); got != 1 {
+		t.Fatalf("expected exactly 1 alert responder, got %d", got)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	first, second := requests[0].Header.Get("Idempotency-Key"), requests[1].Header.Get("Idempotency-Key")
+	if first == "" || first != second {
+		t.Errorf("expected the same Idempotency-Key on both attempts, got %q and %q", first, second)
+	}
+	if ar.URL == "" {
+		t.Error("expected the create response to include the URL")
+	}
+}
+
+func TestCreateAlertResponderConflictsWithExistingName(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "Taken"})
+	c := server.Client()
+
+	_, err := c.CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{
+		TeamName:         "Platform",
+		Name:             "Taken",
+		SlackChannelID:   stringPtr("C123"),
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+	})
+	if !client.IsConflict(err) {
+		t.Fatalf("expected a conflict error, got: %v", err)
+	}
+}
+
+func TestAPIErrorDecoding(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-header")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error":{"code":"VALIDATION_ERROR","message":"invalid alert responder","request_id":"req-123",` +
+			`"field_errors":[{"field":"matching_criteria.text_matches[1]","message":"text match must not be empty"}]}}`))
+	})
+
+	_, err := c.CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{
+		TeamName: "Platform",
+		Name:     "Invalid",
+	})
+	if !client.IsValidationError(err) {
+		t.Fatalf("expected a validation error, got: %v", err)
+	}
+
+	apiErr, ok := client.AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected an *APIError, got %T", err)
+	}
+	if apiErr.Code != "VALIDATION_ERROR" || apiErr.RequestID != "req-123" {
+		t.Errorf("unexpected code %q or request ID %q", apiErr.Code, apiErr.RequestID)
+	}
+	if len(apiErr.FieldErrors) != 1 || apiErr.FieldErrors[0].Field != "matching_criteria.text_matches[1]" {
+		t.Errorf("unexpected field errors: %+v", apiErr.FieldErrors)
+	}
+	if !strings.Contains(err.Error(), "text match must not be empty") {
+		t.Errorf("expected the field error in the message, got: %s", err)
+	}
+}
+
+func TestAPIErrorKinds(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("X-TierZero-Org-Api-Key") != "test-api-key":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":"UNAUTHORIZED","message":"invalid API key"}}`))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/alert-responders/"):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`not found`))
+		default:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"code":"CONFLICT","message":"name taken"}}`))
+		}
+	})
+
+	// A body that is not an error envelope is kept as the message
+	_, err := c.GetAlertResponder(context.Background(), "missing")
+	if !client.IsNotFound(err) || !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+	if apiErr, ok := client.AsAPIError(err); !ok || apiErr.Message != "not found" {
+		t.Errorf("expected the raw body as message, got: %v", err)
+	}
+
+	_, err = c.CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{TeamName: "Platform", Name: "Taken"})
+	if !client.IsConflict(err) || client.IsValidationError(err) {
+		t.Errorf("expected only a conflict error, got: %v", err)
+	}
+
+	c.APIKey = "wrong"
+	_, err = c.ListWebhookSubscriptions(context.Background())
+	if !client.IsUnauthorized(err) {
+		t.Errorf("expected an unauthorized error, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "wrong") {
+		t.Errorf("error must not contain the API key: %s", err)
+	}
+}
+
+func TestCreateAlertResponderSendsGivenIdempotencyKey(t *testing.T) {
+	var keys []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"ar-1"}`))
+	})
+
+	for range 2 {
+		_, err := c.CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{TeamName: "Platform", Name: "Keyed"})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	_, err := c.CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{
+		TeamName:       "Platform",
+		Name:           "Keyed",
+		IdempotencyKey: "given-key",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A key is generated for each create unless one is given
+	if keys[0] == "" || keys[0] == keys[1] || keys[2] != "given-key" {
+		t.Errorf("unexpected Idempotency-Key headers %q", keys)
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
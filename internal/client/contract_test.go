@@ -0,0 +1,692 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+// The contract tests check the client against api/openapi.yaml in two ways:
+// the Go types must have exactly the fields of the schemas they encode, and
+// every request the client sends and every response it receives from the
+// fake API must be valid according to the spec.
+
+const specPath = "../../api/openapi.yaml"
+
+// specBindings maps every schema in the spec to the Go type encoding it.
+// Request types must send every required field.
+var specBindings = map[string]struct {
+	goType  any
+	request bool
+}{
+	"AlertResponder":                       {goType: client.AlertResponder{}},
+	"AlertResponderStatus":                 {goType: ""},
+	"Runbook":                              {goType: client.Runbook{}},
+	"MatchingCriteria":                     {goType: client.MatchingCriteria{}},
+	"MatchingCriteriaPatch":                {goType: client.MatchingCriteria{}, request: true},
+	"WebhookSource":                        {goType: client.WebhookSource{}},
+	"WebhookType":                          {goType: ""},
+	"CreateAlertResponderRequest":          {goType: client.CreateAlertResponderRequest{}, request: true},
+	"UpdateAlertResponderRequest":          {goType: client.UpdateAlertResponderRequest{}, request: true},
+	"ListAlertRespondersResponse":          {goType: client.ListAlertRespondersResponse{}},
+	"WebhookSubscription":                  {goType: client.WebhookSubscription{}},
+	"ListWebhookSubscriptionsResponse":     {goType: client.ListWebhookSubscriptionsResponse{}},
+	"NotificationIntegration":              {goType: client.NotificationIntegration{}},
+	"NotificationIntegrationKind":          {goType: ""},
+	"ListNotificationIntegrationsResponse": {goType: client.ListNotificationIntegrationsResponse{}},
+	"ErrorResponse":                        {goType: client.ErrorEnvelope{}},
+	"Error":                                {goType: client.ErrorEnvelope{}.Error},
+	"FieldError":                           {goType: client.FieldError{}},
+}
+
+type spec struct {
+	Paths      map[string]*specPathItem `yaml:"paths"`
+	Components struct {
+		Schemas    map[string]*specSchema    `yaml:"schemas"`
+		Parameters map[string]*specParameter `yaml:"parameters"`
+		Responses  map[string]*specResponse  `yaml:"responses"`
+	} `yaml:"components"`
+}
+
+type specPathItem struct {
+	Parameters []*specParameter `yaml:"parameters"`
+	Get        *specOperation   `yaml:"get"`
+	Post       *specOperation   `yaml:"post"`
+	Put        *specOperation   `yaml:"put"`
+	Delete     *specOperation   `yaml:"delete"`
+}
+
+type specOperation struct {
+	Parameters  []*specParameter `yaml:"parameters"`
+	RequestBody *struct {
+		Required bool                   `yaml:"required"`
+		Content  map[string]specContent `yaml:"content"`
+	} `yaml:"requestBody"`
+	Responses map[string]*specResponse `yaml:"responses"`
+}
+
+type specParameter struct {
+	Ref    string      `yaml:"$ref"`
+	Name   string      `yaml:"name"`
+	In     string      `yaml:"in"`
+	Schema *specSchema `yaml:"schema"`
+}
+
+type specResponse struct {
+	Ref     string                 `yaml:"$ref"`
+	Content map[string]specContent `yaml:"content"`
+}
+
+type specContent struct {
+	Schema *specSchema `yaml:"schema"`
+}
+
+type specSchema struct {
+	Ref        string                 `yaml:"$ref"`
+	AllOf      []*specSchema          `yaml:"allOf"`
+	Type       string                 `yaml:"type"`
+	Format     string                 `yaml:"format"`
+	Nullable   bool                   `yaml:"nullable"`
+	Enum       []string               `yaml:"enum"`
+	Minimum    *float64               `yaml:"minimum"`
+	MinItems   int                    `yaml:"minItems"`
+	Required   []string               `yaml:"required"`
+	Properties map[string]*specSchema `yaml:"properties"`
+	Items      *specSchema            `yaml:"items"`
+}
+
+func loadSpec(t *testing.T) *spec {
+	t.Helper()
+
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		t.Fatalf("failed to read OpenAPI spec: %s", err)
+	}
+	var s spec
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		t.Fatalf("failed to parse OpenAPI spec: %s", err)
+	}
+	return &s
+}
+
+// schema resolves a $ref and a single-element allOf to the schema they
+// point at
+func (s *spec) schema(schema *specSchema) *specSchema {
+	for {
+		switch {
+		case schema.Ref != "":
+			schema = s.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+		case len(schema.AllOf) == 1:
+			schema = schema.AllOf[0]
+		default:
+			return schema
+		}
+	}
+}
+
+func (s *spec) parameter(p *specParameter) *specParameter {
+	if p.Ref != "" {
+		return s.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+	}
+	return p
+}
+
+func (s *spec) response(r *specResponse) *specResponse {
+	if r.Ref != "" {
+		return s.Components.Responses[strings.TrimPrefix(r.Ref, "#/components/responses/")]
+	}
+	return r
+}
+
+func TestSpecSchemasMatchGoTypes(t *testing.T) {
+	s := loadSpec(t)
+
+	for name := range s.Components.Schemas {
+		if _, ok := specBindings[name]; !ok {
+			t.Errorf("schema %s has no Go type in specBindings", name)
+		}
+	}
+
+	for name, binding := range specBindings {
+		schema, ok := s.Components.Schemas[name]
+		if !ok {
+			t.Errorf("schema %s is bound to %T but missing from the spec", name, binding.goType)
+			continue
+		}
+		compareSchema(t, s, name, schema, reflect.TypeOf(binding.goType), binding.request)
+	}
+}
+
+// compareSchema checks that typ encodes schema: objects must have exactly the
+// schema's properties as JSON fields, recursively
+func compareSchema(t *testing.T, s *spec, where string, schema *specSchema, typ reflect.Type, request bool) {
+	t.Helper()
+
+	schema = s.schema(schema)
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch schema.Type {
+	case "object":
+		if typ.Kind() != reflect.Struct {
+			t.Errorf("%s: spec has an object, Go has %s", where, typ)
+			return
+		}
+		fields := jsonFields(typ)
+		for name, property := range schema.Properties {
+			field, ok := fields[name]
+			if !ok {
+				t.Errorf("%s.%s: in the spec but not in %s", where, name, typ)
+				continue
+			}
+			if request && slices.Contains(schema.Required, name) && field.omitempty {
+				t.Errorf("%s.%s: required by the spec but omitted from requests when empty", where, name)
+			}
+			compareSchema(t, s, where+"."+name, property, field.typ, request)
+		}
+		for name := range fields {
+			if _, ok := schema.Properties[name]; !ok {
+				t.Errorf("%s.%s: in %s but not in the spec", where, name, typ)
+			}
+		}
+	case "array":
+		if typ.Kind() != reflect.Slice {
+			t.Errorf("%s: spec has an array, Go has %s", where, typ)
+			return
+		}
+		compareSchema(t, s, where+"[]", schema.Items, typ.Elem(), request)
+	case "string":
+		if typ.Kind() != reflect.String {
+			t.Errorf("%s: spec has a string, Go has %s", where, typ)
+		}
+	case "integer":
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int32, reflect.Int64:
+		default:
+			t.Errorf("%s: spec has an integer, Go has %s", where, typ)
+		}
+	case "boolean":
+		if typ.Kind() != reflect.Bool {
+			t.Errorf("%s: spec has a boolean, Go has %s", where, typ)
+		}
+	default:
+		t.Errorf("%s: unsupported schema type %q", where, schema.Type)
+	}
+}
+
+type jsonField struct {
+	typ       reflect.Type
+	omitempty bool
+}
+
+// jsonFields returns the JSON-encoded fields of a struct by name
+func jsonFields(typ reflect.Type) map[string]jsonField {
+	fields := map[string]jsonField{}
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = jsonField{
+			typ:       field.Type,
+			omitempty: slices.Contains(strings.Split(options, ","), "omitempty"),
+		}
+	}
+	return fields
+}
+
+func TestSpecAllowsClearingFields(t *testing.T) {
+	s := loadSpec(t)
+
+	for _, field := range []string{
+		client.FieldRunbook,
+		client.FieldRunbookInvestigationPrompt,
+		client.FieldRunbookImpactAndSeverity,
+		client.FieldNotificationIntegrationIDs,
+		client.FieldSlackBotAppUserID,
+	} {
+		schema := s.Components.Schemas["UpdateAlertResponderRequest"]
+		for _, name := range strings.Split(field, ".") {
+			schema = s.schema(schema).Properties[name]
+			if schema == nil {
+				break
+			}
+		}
+		if schema == nil || !schema.Nullable {
+			t.Errorf("%s can be cleared by the client but is not nullable in UpdateAlertResponderRequest", field)
+		}
+	}
+}
+
+func TestClientTrafficMatchesSpec(t *testing.T) {
+	s := loadSpec(t)
+	server := tierzerotest.NewServer(t, tierzerotest.WithPageSize(1))
+	server.AddWebhookSubscription(client.WebhookSubscription{Type: "PAGERDUTY", RemoteID: "PABC123", Name: "Production"})
+	integration := server.AddNotificationIntegration(client.NotificationIntegration{Name: "#incidents", Kind: "SLACK_ALERT"})
+
+	recorder := &recordingTransport{next: http.DefaultTransport}
+	c := server.Client()
+	c.MaxRetries = 0
+	c.HTTPClient.Transport = recorder
+	unauthorized := client.NewClient(server.URL, "invalid")
+	unauthorized.MaxRetries = 0
+	unauthorized.HTTPClient.Transport = recorder
+
+	ctx := context.Background()
+	kind := "SLACK_ALERT"
+	calls := []func() error{
+		func() error { _, err := c.ListWebhookSubscriptions(ctx); return err },
+		func() error { _, err := c.ListNotificationIntegrations(ctx, &kind); return err },
+		func() error { _, err := c.GetDefaultRunbook(ctx); return err },
+		func() error {
+			_, err := unauthorized.ListWebhookSubscriptions(ctx)
+			return expectError(err, client.IsUnauthorized)
+		},
+		func() error {
+			_, err := c.CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+				TeamName:                   "Platform",
+				Name:                       "Webhook",
+				WebhookSources:             []client.WebhookSource{{Type: "PAGERDUTY", RemoteID: "PABC123"}},
+				MatchingCriteria:           &client.MatchingCriteria{TextMatches: []string{"error"}},
+				Runbook:                    &client.Runbook{InvestigationPrompt: "Investigate", ImpactAndSeverityPrompt: "Assess"},
+				NotificationIntegrationIDs: []string{integration.ID},
+			})
+			return err
+		},
+		func() error {
+			_, err := c.CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+				TeamName:         "Platform",
+				Name:             "Webhook",
+				SlackChannelID:   stringPtr("C123"),
+				MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+			})
+			return expectError(err, client.IsConflict)
+		},
+		func() error {
+			_, err := c.CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+				TeamName:         "Platform",
+				Name:             "Invalid",
+				SlackChannelID:   stringPtr("C123"),
+				MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{""}},
+			})
+			return expectError(err, client.IsValidationError)
+		},
+		func() error {
+			ar, err := c.CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+				TeamName:         "Platform",
+				Name:             "Slack",
+				SlackChannelID:   stringPtr("C123"),
+				MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}, SlackBotAppUserID: stringPtr("B123")},
+				Runbook:          &client.Runbook{InvestigationPrompt: "Investigate"},
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := c.GetAlertResponder(ctx, ar.ID); err != nil {
+				return err
+			}
+			if _, err := c.UpdateAlertResponder(ctx, ar.ID, &client.UpdateAlertResponderRequest{
+				Name:  stringPtr("Slack (renamed)"),
+				Clear: []string{client.FieldRunbookInvestigationPrompt, client.FieldSlackBotAppUserID},
+			}); err != nil {
+				return err
+			}
+			if _, err := c.DisableAlertResponder(ctx, ar.ID); err != nil {
+				return err
+			}
+			if _, err := c.EnableAlertResponder(ctx, ar.ID); err != nil {
+				return err
+			}
+			if _, err := c.ListAlertResponders(ctx, &client.ListAlertRespondersOptions{
+				TeamName:   "Platform",
+				Status:     "ACTIVE",
+				NamePrefix: "Slack",
+				SourceType: "SLACK",
+				PageSize:   1,
+			}); err != nil {
+				return err
+			}
+			// Two responders in pages of one, to follow a cursor
+			if _, err := c.ListAlertResponders(ctx, nil); err != nil {
+				return err
+			}
+			if err := c.DeleteAlertResponder(ctx, ar.ID); err != nil {
+				return err
+			}
+			_, err = c.GetAlertResponder(ctx, ar.ID)
+			return expectError(err, client.IsNotFound)
+		},
+		func() error {
+			// The responder deleted above, listed in pages of one after a
+			// second deleted responder to follow a cursor
+			deleted, err := c.ListAlertResponders(ctx, &client.ListAlertRespondersOptions{NamePrefix: "Webhook"})
+			if err != nil || len(deleted) != 1 {
+				return fmt.Errorf("expected the webhook responder, got %v: %v", deleted, err)
+			}
+			if err := c.DeleteAlertResponder(ctx, deleted[0].ID); err != nil {
+				return err
+			}
+			if _, err := c.ListDeletedAlertResponders(ctx, &client.ListDeletedAlertRespondersOptions{
+				TeamName:   "Platform",
+				NamePrefix: "Slack",
+				PageSize:   1,
+			}); err != nil {
+				return err
+			}
+			deleted, err = c.ListDeletedAlertResponders(ctx, nil)
+			if err != nil {
+				return err
+			}
+			if _, err := c.RestoreAlertResponder(ctx, deleted[0].ID); err != nil {
+				return err
+			}
+			if err := c.PurgeAlertResponder(ctx, deleted[0].ID); err != nil {
+				return err
+			}
+			if err := c.PurgeAlertResponder(ctx, deleted[1].ID); err != nil {
+				return err
+			}
+			_, err = c.RestoreAlertResponder(ctx, deleted[1].ID)
+			return expectError(err, client.IsNotFound)
+		},
+	}
+	for i, call := range calls {
+		if err := call(); err != nil {
+			t.Fatalf("call %d: %s", i, err)
+		}
+	}
+
+	for _, exchange := range recorder.exchanges {
+		for _, problem := range validateExchange(s, exchange) {
+			t.Errorf("%s %s: %s", exchange.method, exchange.url, problem)
+		}
+	}
+}
+
+func expectError(err error, is func(error) bool) error {
+	if err == nil || !is(err) {
+		return fmt.Errorf("unexpected error: %v", err)
+	}
+	return nil
+}
+
+// exchange is a request sent by the client along with the response received
+type exchange struct {
+	method       string
+	url          string
+	path         string
+	query        map[string][]string
+	header       http.Header
+	requestBody  []byte
+	statusCode   int
+	responseBody []byte
+}
+
+type recordingTransport struct {
+	next      http.RoundTripper
+	exchanges []exchange
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	e := exchange{
+		method: req.Method,
+		url:    req.URL.RequestURI(),
+		path:   req.URL.Path,
+		query:  req.URL.Query(),
+		header: req.Header.Clone(),
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		e.requestBody = body
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	e.statusCode = resp.StatusCode
+	e.responseBody = body
+
+	rt.exchanges = append(rt.exchanges, e)
+	return resp, nil
+}
+
+// standardHeaders are request headers that need not be declared in the spec
+var standardHeaders = []string{"Accept-Encoding", "Content-Length", "Content-Type", "User-Agent", "X-Tierzero-Org-Api-Key"}
+
+// validateExchange returns every way the exchange violates the spec
+func validateExchange(s *spec, e exchange) []string {
+	item, template := findPath(s, e.path)
+	if item == nil {
+		return []string{"path is not in the spec"}
+	}
+	op := map[string]*specOperation{
+		http.MethodGet:    item.Get,
+		http.MethodPost:   item.Post,
+		http.MethodPut:    item.Put,
+		http.MethodDelete: item.Delete,
+	}[e.method]
+	if op == nil {
+		return []string{fmt.Sprintf("method is not defined for %s", template)}
+	}
+
+	var problems []string
+	params := map[string]*specParameter{}
+	for _, p := range append(slices.Clone(item.Parameters), op.Parameters...) {
+		p = s.parameter(p)
+		params[p.In+":"+strings.ToLower(p.Name)] = p
+	}
+	for name, values := range e.query {
+		p, ok := params["query:"+strings.ToLower(name)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("query parameter %q is not in the spec", name))
+			continue
+		}
+		for _, value := range values {
+			problems = append(problems, validateParameter(s, "query parameter "+name, p.Schema, value)...)
+		}
+	}
+	for name := range e.header {
+		if slices.Contains(standardHeaders, name) {
+			continue
+		}
+		if _, ok := params["header:"+strings.ToLower(name)]; !ok {
+			problems = append(problems, fmt.Sprintf("header %q is not in the spec", name))
+		}
+	}
+
+	switch {
+	case len(e.requestBody) > 0 && op.RequestBody == nil:
+		problems = append(problems, "request body is not in the spec")
+	case len(e.requestBody) > 0:
+		problems = append(problems, validateJSON(s, "request", op.RequestBody.Content["application/json"].Schema, e.requestBody)...)
+	case op.RequestBody != nil && op.RequestBody.Required:
+		problems = append(problems, "required request body is missing")
+	}
+
+	resp := op.Responses[strconv.Itoa(e.statusCode)]
+	if resp == nil {
+		resp = op.Responses["default"]
+	}
+	if resp == nil {
+		return append(problems, fmt.Sprintf("response status %d is not in the spec", e.statusCode))
+	}
+	resp = s.response(resp)
+	content, ok := resp.Content["application/json"]
+	switch {
+	case len(e.responseBody) > 0 && !ok:
+		problems = append(problems, fmt.Sprintf("response %d has a body but none is in the spec", e.statusCode))
+	case len(e.responseBody) > 0:
+		problems = append(problems, validateJSON(s, fmt.Sprintf("response %d", e.statusCode), content.Schema, e.responseBody)...)
+	case ok:
+		problems = append(problems, fmt.Sprintf("response %d has no body", e.statusCode))
+	}
+
+	return problems
+}
+
+// findPath returns the path item whose template matches path
+func findPath(s *spec, path string) (*specPathItem, string) {
+	segments := strings.Split(path, "/")
+	for template, item := range s.Paths {
+		templateSegments := strings.Split(template, "/")
+		if len(templateSegments) != len(segments) {
+			continue
+		}
+		match := true
+		for i, segment := range templateSegments {
+			if !strings.HasPrefix(segment, "{") && segment != segments[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return item, template
+		}
+	}
+	return nil, ""
+}
+
+func validateParameter(s *spec, where string, schema *specSchema, value string) []string {
+	schema = s.schema(schema)
+	if schema.Type == "boolean" {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return []string{fmt.Sprintf("%s: %q is not a boolean", where, value)}
+		}
+		return validateValue(s, where, schema, b)
+	}
+	if schema.Type == "integer" {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return []string{fmt.Sprintf("%s: %q is not an integer", where, value)}
+		}
+		return validateValue(s, where, schema, float64(n))
+	}
+	return validateValue(s, where, schema, value)
+}
+
+func validateJSON(s *spec, where string, schema *specSchema, data []byte) []string {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return []string{fmt.Sprintf("%s: invalid JSON: %s", where, err)}
+	}
+	return validateValue(s, where, schema, value)
+}
+
+// validateValue validates a decoded JSON value against a schema. Objects may
+// only contain declared properties.
+func validateValue(s *spec, where string, schema *specSchema, value any) []string {
+	if schema.Ref != "" {
+		schema = s.schema(schema)
+	}
+	if value == nil {
+		if schema.Nullable {
+			return nil
+		}
+		return []string{where + ": null is not allowed"}
+	}
+	if len(schema.AllOf) > 0 {
+		var problems []string
+		for _, sub := range schema.AllOf {
+			problems = append(problems, validateValue(s, where, sub, value)...)
+		}
+		return problems
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an object, got %T", where, value)}
+		}
+		var problems []string
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: required property %q is missing", where, name))
+			}
+		}
+		for name, v := range object {
+			property, ok := schema.Properties[name]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: property %q is not in the spec", where, name))
+				continue
+			}
+			problems = append(problems, validateValue(s, where+"."+name, property, v)...)
+		}
+		return problems
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an array, got %T", where, value)}
+		}
+		var problems []string
+		if len(array) < schema.MinItems {
+			problems = append(problems, fmt.Sprintf("%s: expected at least %d items, got %d", where, schema.MinItems, len(array)))
+		}
+		for i, v := range array {
+			problems = append(problems, validateValue(s, fmt.Sprintf("%s[%d]", where, i), schema.Items, v)...)
+		}
+		return problems
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected a string, got %T", where, value)}
+		}
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, str) {
+			return []string{fmt.Sprintf("%s: %q is not one of %v", where, str, schema.Enum)}
+		}
+		if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				return []string{fmt.Sprintf("%s: %q is not a date-time", where, str)}
+			}
+		}
+		return nil
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != float64(int64(n)) {
+			return []string{fmt.Sprintf("%s: expected an integer, got %v", where, value)}
+		}
+		if schema.Minimum != nil && n < *schema.Minimum {
+			return []string{fmt.Sprintf("%s: %v is less than %v", where, n, *schema.Minimum)}
+		}
+		return nil
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []string{fmt.Sprintf("%s: expected a boolean, got %T", where, value)}
+		}
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: unsupported schema type %q", where, schema.Type)}
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetDefaultRunbook retrieves the organization's default runbook, whose
+// prompts are used by alert responders that do not set their own
+func (c *Client) GetDefaultRunbook(ctx context.Context) (*Runbook, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/default-runbook", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default runbook: %w", err)
+	}
+
+	var runbook Runbook
+	if err := json.Unmarshal(respBody, &runbook); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &runbook, nil
+}
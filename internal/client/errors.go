@@ -0,0 +1,153 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+// Error kinds reported by the API. Use errors.Is to check an error returned
+// by the client against one of these, or the Is* helpers below.
+var (
+	ErrValidation    = errors.New("validation error")
+	ErrConflict      = errors.New("conflict")
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrNotFound      = errors.New("not found")
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// API error codes returned in the error envelope
+const (
+	errorCodeValidation    = "VALIDATION_ERROR"
+	errorCodeConflict      = "CONFLICT"
+	errorCodeUnauthorized  = "UNAUTHORIZED"
+	errorCodeForbidden     = "FORBIDDEN"
+	errorCodeNotFound      = "NOT_FOUND"
+	errorCodeQuotaExceeded = "QUOTA_EXCEEDED"
+	errorCodeRateLimited   = "RATE_LIMITED"
+)
+
+// APIError represents an error response from the API
+type APIError struct {
+	StatusCode int
+	Message    string
+
+	// Code is the machine-readable error code (e.g. VALIDATION_ERROR), if any
+	Code string
+	// RequestID identifies the failed request in TierZero's logs
+	RequestID string
+	// FieldErrors lists per-field validation failures
+	FieldErrors []FieldError
+}
+
+// FieldError describes why the value of a single request field was rejected
+type FieldError struct {
+	// Field is the path of the offending field, e.g. "matching_criteria.text_matches[2]"
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// errorEnvelope is the JSON body of an API error response
+type errorEnvelope struct {
+	Error struct {
+		Code        string       `json:"code"`
+		Message     string       `json:"message"`
+		RequestID   string       `json:"request_id"`
+		FieldErrors []FieldError `json:"field_errors"`
+	} `json:"error"`
+}
+
+// newAPIError decodes an error response. Bodies that do not match the error
+// envelope are kept verbatim as the message.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    string(body),
+		RequestID:  resp.Header.Get(requestIDHeader),
+	}
+
+	var envelope errorEnvelope
+	if err := json.Unmarshal(body, &envelope); err == nil && (envelope.Error.Code != "" || envelope.Error.Message != "") {
+		apiErr.Code = envelope.Error.Code
+		apiErr.Message = envelope.Error.Message
+		apiErr.FieldErrors = envelope.Error.FieldErrors
+		if envelope.Error.RequestID != "" {
+			apiErr.RequestID = envelope.Error.RequestID
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "API error (status %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, ", code %s", e.Code)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, ", request ID %s", e.RequestID)
+	}
+	fmt.Fprintf(&b, "): %s", e.Message)
+	for _, fe := range e.FieldErrors {
+		fmt.Fprintf(&b, "; %s: %s", fe.Field, fe.Message)
+	}
+	return b.String()
+}
+
+// Is reports whether the error belongs to the given error kind, so that
+// errors.Is(err, ErrConflict) works on wrapped API errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrValidation:
+		return e.Code == errorCodeValidation || e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrConflict:
+		return e.Code == errorCodeConflict || e.StatusCode == http.StatusConflict
+	case ErrUnauthorized:
+		return e.Code == errorCodeUnauthorized || e.Code == errorCodeForbidden ||
+			e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.Code == errorCodeNotFound || e.StatusCode == http.StatusNotFound
+	case ErrQuotaExceeded:
+		return e.Code == errorCodeQuotaExceeded || e.Code == errorCodeRateLimited || e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// AsAPIError returns the APIError wrapped in err, if any
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound returns true if the error is a 404 Not Found
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsValidationError returns true if the API rejected the request payload
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsConflict returns true if the request conflicts with the current state of a resource
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsUnauthorized returns true if the API key is missing, invalid or lacks permission
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsQuotaExceeded returns true if the organization hit a quota or rate limit
+func IsQuotaExceeded(err error) bool {
+	return errors.Is(err, ErrQuotaExceeded)
+}
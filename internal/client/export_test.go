@@ -0,0 +1,16 @@
+package client
+
+// ErrorEnvelope exposes errorEnvelope to the contract tests
+type ErrorEnvelope = errorEnvelope
+
+// CloseHARRecorders closes the HAR files opened so far, as if the provider
+// process had exited
+func CloseHARRecorders() {
+	harRecordersMu.Lock()
+	defer harRecordersMu.Unlock()
+
+	for path, rec := range harRecorders {
+		rec.file.Close()
+		delete(harRecorders, path)
+	}
+}
@@ -0,0 +1,264 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// harRecorder records every API exchange into an HTTP Archive (HAR 1.2)
+// file that can be attached to support tickets. Secrets are redacted before
+// they are recorded.
+type harRecorder struct {
+	mu   sync.Mutex
+	file *os.File
+	// end is the offset of the trailer, where the next entry is written
+	end int64
+	// empty is true until the file holds an entry
+	empty bool
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Error           string      `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	Cookies     []harNameValue `json:"cookies"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Cookies     []harNameValue `json:"cookies"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// EnableHARRecording records every subsequent request and response into a
+// HAR file at path. Each exchange is appended to the file as it happens, so
+// the file stays valid even if the provider process is killed, and a file
+// left by an earlier run (for example the plan before an apply) is extended
+// rather than overwritten. version identifies the provider build in the HAR
+// creator field of a new file.
+func (c *Client) EnableHARRecording(path, version string) error {
+	rec, err := openHARRecorder(path, version)
+	if err != nil {
+		return err
+	}
+	c.har = rec
+	return nil
+}
+
+// recordHAR appends an exchange to the HAR file. resp is nil when the round
+// trip failed, in which case err describes the failure.
+func (c *Client) recordHAR(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, started time.Time, latency time.Duration, err error) {
+	if c.har == nil {
+		return
+	}
+
+	ms := float64(latency) / float64(time.Millisecond)
+	entry := harEntry{
+		StartedDateTime: started.Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: "HTTP/1.1",
+			Headers:     harHeaders(req.Header),
+			QueryString: []harNameValue{},
+			Cookies:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: harResponse{
+			Headers:     []harNameValue{},
+			Cookies:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: harTimings{Wait: ms},
+	}
+	for name, values := range req.URL.Query() {
+		for _, value := range values {
+			entry.Request.QueryString = append(entry.Request.QueryString, harNameValue{Name: name, Value: value})
+		}
+	}
+	if len(reqBody) > 0 {
+		entry.Request.PostData = &harPostData{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     c.redactBody(reqBody),
+		}
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Response.Status = resp.StatusCode
+		entry.Response.StatusText = http.StatusText(resp.StatusCode)
+		entry.Response.HTTPVersion = resp.Proto
+		entry.Response.Headers = harHeaders(resp.Header)
+		entry.Response.BodySize = len(respBody)
+		entry.Response.Content = harContent{
+			Size:     len(respBody),
+			MimeType: resp.Header.Get("Content-Type"),
+			Text:     c.redactBody(respBody),
+		}
+	}
+
+	c.har.add(entry)
+}
+
+// harHeaders converts headers to HAR name/value pairs, redacting secrets
+func harHeaders(header http.Header) []harNameValue {
+	result := []harNameValue{}
+	for name, value := range redactHeaders(header) {
+		result = append(result, harNameValue{Name: name, Value: value})
+	}
+	return result
+}
+
+// harRecorders holds the recorders opened by this process, keyed by path, so
+// provider instances configured with the same file share its recorder
+// instead of overwriting each other's entries.
+var (
+	harRecordersMu sync.Mutex
+	harRecorders   = map[string]*harRecorder{}
+)
+
+// harTrailer closes the entries array and the log. The file always ends with
+// it, and each new entry is written over it.
+const harTrailer = "\n]}}\n"
+
+// openHARRecorder returns the recorder for path, creating the file with an
+// empty log if it does not exist yet.
+func openHARRecorder(path, version string) (*harRecorder, error) {
+	harRecordersMu.Lock()
+	defer harRecordersMu.Unlock()
+
+	if rec, ok := harRecorders[path]; ok {
+		return rec, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open HAR file: %w", err)
+	}
+	rec := &harRecorder{file: file}
+	if err := rec.init(version); err != nil {
+		file.Close()
+		return nil, err
+	}
+	harRecorders[path] = rec
+	return rec, nil
+}
+
+// init writes the header of a new file, or finds where the next entry goes
+// in an existing one.
+func (r *harRecorder) init(version string) error {
+	info, err := r.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to read HAR file: %w", err)
+	}
+
+	if info.Size() == 0 {
+		creator, err := json.Marshal(harCreator{Name: "terraform-provider-tierzero", Version: version})
+		if err != nil {
+			return fmt.Errorf("failed to encode HAR file: %w", err)
+		}
+		header := `{"log":{"version":"1.2","creator":` + string(creator) + `,"entries":[`
+		if _, err := r.file.WriteAt([]byte(header+harTrailer), 0); err != nil {
+			return fmt.Errorf("failed to write HAR file: %w", err)
+		}
+		r.end = int64(len(header))
+		r.empty = true
+		return nil
+	}
+
+	// Only a file written by this recorder can be extended: it must end with
+	// the trailer, preceded by either the opening bracket or an entry.
+	tail := make([]byte, len(harTrailer)+1)
+	if info.Size() < int64(len(tail)) {
+		return fmt.Errorf("%s is not a HAR file recorded by this provider", r.file.Name())
+	}
+	if _, err := r.file.ReadAt(tail, info.Size()-int64(len(tail))); err != nil {
+		return fmt.Errorf("failed to read HAR file: %w", err)
+	}
+	if string(tail[1:]) != harTrailer {
+		return fmt.Errorf("%s is not a HAR file recorded by this provider", r.file.Name())
+	}
+	r.end = info.Size() - int64(len(harTrailer))
+	r.empty = tail[0] == '['
+	return nil
+}
+
+// add appends an entry to the file, overwriting the trailer and writing it
+// back after the entry. Recording is best effort: encoding and write failures
+// never fail the API request being recorded.
+func (r *harRecorder) add(entry harEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	separator := ",\n"
+	if r.empty {
+		separator = "\n"
+	}
+	chunk := append([]byte(separator), data...)
+	if _, err := r.file.WriteAt(append(chunk, harTrailer...), r.end); err != nil {
+		return
+	}
+	r.end += int64(len(chunk))
+	r.empty = false
+}
@@ -0,0 +1,128 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+// harFile is the subset of a HAR file checked by the tests
+type harFile struct {
+	Log struct {
+		Version string `json:"version"`
+		Entries []struct {
+			Request struct {
+				Method string `json:"method"`
+				URL    string `json:"url"`
+			} `json:"request"`
+			Response struct {
+				Status int `json:"status"`
+			} `json:"response"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+// readHARFile decodes the HAR file at path, failing the test if it is not
+// valid JSON
+func readHARFile(t *testing.T, path string) harFile {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read HAR file: %s", err)
+	}
+	var har harFile
+	if err := json.Unmarshal(data, &har); err != nil {
+		t.Fatalf("HAR file is not valid JSON: %s\n%s", err, data)
+	}
+	return har
+}
+
+// enableHAR records the API exchanges of c into path
+func enableHAR(t *testing.T, c *client.Client, path string) {
+	t.Helper()
+
+	t.Cleanup(client.CloseHARRecorders)
+	if err := c.EnableHARRecording(path, "test"); err != nil {
+		t.Fatalf("failed to enable HAR recording: %s", err)
+	}
+}
+
+func TestHARRecordingRecordsExchanges(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	path := filepath.Join(t.TempDir(), "tierzero.har")
+	c := server.Client()
+	enableHAR(t, c, path)
+
+	if har := readHARFile(t, path); har.Log.Version != "1.2" || len(har.Log.Entries) != 0 {
+		t.Fatalf("expected an empty HAR 1.2 log, got %+v", har.Log)
+	}
+
+	ctx := context.Background()
+	if _, err := c.ListWebhookSubscriptions(ctx); err != nil {
+		t.Fatalf("failed to list webhook subscriptions: %s", err)
+	}
+	if _, err := c.ListNotificationIntegrations(ctx, nil); err != nil {
+		t.Fatalf("failed to list notification integrations: %s", err)
+	}
+
+	har := readHARFile(t, path)
+	if len(har.Log.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(har.Log.Entries))
+	}
+	for _, entry := range har.Log.Entries {
+		if entry.Request.Method != "GET" || entry.Response.Status != 200 {
+			t.Errorf("unexpected entry %+v", entry)
+		}
+	}
+}
+
+func TestHARRecordingAppendsToExistingFile(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	path := filepath.Join(t.TempDir(), "tierzero.har")
+	ctx := context.Background()
+
+	// A first run, such as terraform plan
+	first := server.Client()
+	enableHAR(t, first, path)
+	if _, err := first.ListWebhookSubscriptions(ctx); err != nil {
+		t.Fatalf("failed to list webhook subscriptions: %s", err)
+	}
+	client.CloseHARRecorders()
+
+	// The following run, with two provider instances sharing the file
+	second, third := server.Client(), server.Client()
+	enableHAR(t, second, path)
+	enableHAR(t, third, path)
+	if _, err := second.ListWebhookSubscriptions(ctx); err != nil {
+		t.Fatalf("failed to list webhook subscriptions: %s", err)
+	}
+	if _, err := third.ListWebhookSubscriptions(ctx); err != nil {
+		t.Fatalf("failed to list webhook subscriptions: %s", err)
+	}
+
+	if got := len(readHARFile(t, path).Log.Entries); got != 3 {
+		t.Errorf("expected the 3 exchanges to be recorded, got %d", got)
+	}
+}
+
+func TestHARRecordingRejectsOtherFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte("not a HAR file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := client.NewClient("http://localhost", "test-api-key")
+	t.Cleanup(client.CloseHARRecorders)
+	if err := c.EnableHARRecording(path, "test"); err == nil {
+		t.Fatal("expected an error for a file not recorded by the provider")
+	}
+	if data, _ := os.ReadFile(path); string(data) != "not a HAR file\n" {
+		t.Errorf("expected the file to be left untouched, got %q", data)
+	}
+}
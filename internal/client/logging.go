@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redacted replaces secret values in logs and HAR recordings
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are never logged or recorded in clear text
+var sensitiveHeaders = map[string]bool{
+	http.CanonicalHeaderKey(apiKeyHeader): true,
+	"Authorization":                       true,
+	"Cookie":                              true,
+	"Set-Cookie":                          true,
+}
+
+// sensitiveFields are JSON body fields whose values are always redacted
+var sensitiveFields = map[string]bool{
+	"api_key":       true,
+	"apikey":        true,
+	"password":      true,
+	"secret":        true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+	"authorization": true,
+}
+
+// loggingContext masks the API key in every log entry emitted with the
+// returned context, as a safety net on top of the explicit redaction below
+func (c *Client) loggingContext(ctx context.Context) context.Context {
+	if c.APIKey == "" {
+		return ctx
+	}
+	return tflog.MaskAllFieldValuesStrings(ctx, c.APIKey)
+}
+
+// logRequest traces an outgoing request. Bodies are only logged at TRACE level.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, body []byte) {
+	tflog.Debug(ctx, "Sending TierZero API request", map[string]interface{}{
+		"http_method": req.Method,
+		"http_path":   req.URL.RequestURI(),
+	})
+	tflog.Trace(ctx, "TierZero API request details", map[string]interface{}{
+		"http_method":          req.Method,
+		"http_path":            req.URL.RequestURI(),
+		"http_request_headers": redactHeaders(req.Header),
+		"http_request_body":    c.redactBody(body),
+	})
+}
+
+// logResponse traces a received response or a failed round trip
+func (c *Client) logResponse(ctx context.Context, req *http.Request, resp *http.Response, body []byte, latency time.Duration, err error) {
+	fields := map[string]interface{}{
+		"http_method":     req.Method,
+		"http_path":       req.URL.RequestURI(),
+		"http_latency_ms": latency.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "TierZero API request failed", fields)
+		return
+	}
+
+	fields["http_status"] = resp.StatusCode
+	if requestID := resp.Header.Get(requestIDHeader); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	tflog.Debug(ctx, "Received TierZero API response", fields)
+
+	fields["http_response_headers"] = redactHeaders(resp.Header)
+	fields["http_response_body"] = c.redactBody(body)
+	tflog.Trace(ctx, "TierZero API response details", fields)
+}
+
+// redactHeaders returns a copy of the headers with secret values replaced
+func redactHeaders(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for name, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			result[name] = redacted
+			continue
+		}
+		result[name] = strings.Join(values, ", ")
+	}
+	return result
+}
+
+// redactBody returns the body as a string with secret JSON fields and any
+// occurrence of the API key replaced. Non-JSON bodies are only scrubbed of
+// the API key.
+func (c *Client) redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	text := string(body)
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err == nil {
+		if data, err := json.Marshal(redactValue(value)); err == nil {
+			text = string(data)
+		}
+	}
+
+	if c.APIKey != "" {
+		text = strings.ReplaceAll(text, c.APIKey, redacted)
+	}
+	return text
+}
+
+// redactValue walks a decoded JSON value and redacts sensitive fields
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+// secretResponse holds secrets nested at several levels, plus the API key
+// echoed back in a regular field
+const secretResponse = `{
+  "webhook_subscriptions": [
+    {"type": "PAGERDUTY", "remote_id": "P1", "name": "echo test-api-key"}
+  ],
+  "details": {
+    "integration": {"Secret": "nested-secret-value"},
+    "sessions": [{"access_token": "nested-token-value", "user": {"password": "nested-password-value"}}]
+  }
+}`
+
+// secrets must never appear in logs or HAR recordings
+var secrets = []string{"test-api-key", "nested-secret-value", "nested-token-value", "nested-password-value", "session=cookie-value"}
+
+func TestSecretsAreRedacted(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "cookie-value"})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(secretResponse))
+	})
+	path := filepath.Join(t.TempDir(), "tierzero.har")
+	enableHAR(t, c, path)
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	if _, err := c.ListWebhookSubscriptions(ctx); err != nil {
+		t.Fatalf("failed to list webhook subscriptions: %s", err)
+	}
+	// The API key in a request body, e.g. pasted into a name by mistake
+	slackChannelID := "C123"
+	_, _ = c.CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+		TeamName:       "Platform",
+		Name:           "test-api-key",
+		SlackChannelID: &slackChannelID,
+	})
+
+	har, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read HAR file: %s", err)
+	}
+
+	outputs := map[string]string{"logs": logs.String(), "HAR file": string(har)}
+	for name, output := range outputs {
+		// Headers and bodies must have been recorded for the test to mean anything
+		for _, want := range []string{"X-Tierzero-Org-Api-Key", "webhook_subscriptions", "echo [REDACTED]"} {
+			if !strings.Contains(output, want) {
+				t.Errorf("expected %s to contain %q", name, want)
+			}
+		}
+		for _, secret := range secrets {
+			if strings.Contains(output, secret) {
+				t.Errorf("%s contains the secret %q:\n%s", name, secret, output)
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // NotificationIntegration represents a notification integration available in the organization
@@ -25,7 +26,7 @@ type ListNotificationIntegrationsResponse struct {
 func (c *Client) ListNotificationIntegrations(ctx context.Context, kind *string) ([]NotificationIntegration, error) {
 	path := "/api/v1/notification-integrations"
 	if kind != nil && *kind != "" {
-		path = fmt.Sprintf("%s?kind=%s", path, *kind)
+		path += "?" + url.Values{"kind": {*kind}}.Encode()
 	}
 
 	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
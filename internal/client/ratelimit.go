@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultRequestsPerSecond     = 10
+	defaultMaxConcurrentRequests = 10
+)
+
+// SetRateLimit limits the client to requestsPerSecond requests on average
+// using a token bucket. Bursts of up to requestsPerSecond requests (at least
+// one) are allowed. A value of zero or less removes the limit.
+func (c *Client) SetRateLimit(requestsPerSecond float64) {
+	if requestsPerSecond <= 0 {
+		c.limiter = nil
+		return
+	}
+	burst := max(int(math.Ceil(requestsPerSecond)), 1)
+	c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
+
+// SetMaxConcurrentRequests caps the number of requests in flight at once.
+// A value of zero or less removes the cap.
+func (c *Client) SetMaxConcurrentRequests(n int) {
+	if n <= 0 {
+		c.inflight = nil
+		return
+	}
+	c.inflight = make(chan struct{}, n)
+}
+
+// RateLimit returns the average number of requests per second allowed, zero
+// when requests are not rate limited
+func (c *Client) RateLimit() float64 {
+	if c.limiter == nil {
+		return 0
+	}
+	return float64(c.limiter.Limit())
+}
+
+// MaxConcurrentRequests returns the number of requests allowed in flight at
+// once, zero when it is not capped
+func (c *Client) MaxConcurrentRequests() int {
+	return cap(c.inflight)
+}
+
+// acquire blocks until the request is allowed by both the rate limiter and
+// the concurrency cap. The returned function must be called to release the
+// concurrency slot once the request completes.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limiter: %w", err)
+		}
+	}
+
+	if c.inflight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.inflight <- struct{}{}:
+		return func() { <-c.inflight }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for a concurrent request slot: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,190 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// blockingHandler answers once release is closed, tracking the number of
+// requests in flight and the highest number seen at once
+type blockingHandler struct {
+	release  chan struct{}
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{release: make(chan struct{})}
+}
+
+func (h *blockingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := h.inFlight.Add(1)
+	defer h.inFlight.Add(-1)
+	for {
+		peak := h.peak.Load()
+		if n <= peak || h.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	select {
+	case <-h.release:
+	case <-r.Context().Done():
+		return
+	}
+	_, _ = w.Write([]byte(`{"webhook_subscriptions":[]}`))
+}
+
+// waitFor polls cond until it holds or the timeout expires
+func waitFor(t *testing.T, timeout time.Duration, cond func() bool) bool {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return cond()
+}
+
+func TestMaxConcurrentRequestsCapsInFlightRequests(t *testing.T) {
+	handler := newBlockingHandler()
+	c := newTestClient(t, handler.ServeHTTP)
+	c.SetRateLimit(0)
+	c.SetMaxConcurrentRequests(2)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.ListWebhookSubscriptions(context.Background()); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	if !waitFor(t, 5*time.Second, func() bool { return handler.inFlight.Load() == 2 }) {
+		t.Fatalf("expected 2 requests in flight, got %d", handler.inFlight.Load())
+	}
+	// Give the other requests a chance to exceed the cap
+	time.Sleep(50 * time.Millisecond)
+	close(handler.release)
+	wg.Wait()
+
+	if got := handler.peak.Load(); got != 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestRateLimitThrottlesRequests(t *testing.T) {
+	handler := newBlockingHandler()
+	close(handler.release)
+	c := newTestClient(t, handler.ServeHTTP)
+	c.SetMaxConcurrentRequests(0)
+	// A burst of 20 requests, then one every 50ms
+	c.SetRateLimit(20)
+
+	start := time.Now()
+	for range 30 {
+		if _, err := c.ListWebhookSubscriptions(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// The 10 requests after the burst need about 500ms of tokens
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected 30 requests at 20 per second to take at least 400ms, took %s", elapsed)
+	}
+}
+
+func TestRateLimitWaitIsCanceledWithContext(t *testing.T) {
+	handler := newBlockingHandler()
+	close(handler.release)
+	c := newTestClient(t, handler.ServeHTTP)
+	c.SetMaxConcurrentRequests(0)
+	c.SetRateLimit(0.1)
+
+	// The first request takes the only token for the next 10 seconds
+	if _, err := c.ListWebhookSubscriptions(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := c.ListWebhookSubscriptions(ctx); err == nil {
+		t.Fatal("expected an error waiting for the rate limiter")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the wait to end with the context, took %s", elapsed)
+	}
+	if got := handler.peak.Load(); got != 1 {
+		t.Errorf("expected the second request to never be sent, got %d in flight", got)
+	}
+}
+
+func TestConcurrencyWaitIsCanceledWithContext(t *testing.T) {
+	handler := newBlockingHandler()
+	c := newTestClient(t, handler.ServeHTTP)
+	c.SetRateLimit(0)
+	c.SetMaxConcurrentRequests(1)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = c.ListWebhookSubscriptions(context.Background())
+	}()
+	if !waitFor(t, 5*time.Second, func() bool { return handler.inFlight.Load() == 1 }) {
+		t.Fatal("expected the first request to be in flight")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	if _, err := c.ListWebhookSubscriptions(ctx); err == nil {
+		t.Error("expected an error waiting for a concurrent request slot")
+	}
+
+	close(handler.release)
+	<-done
+	if got := handler.peak.Load(); got != 1 {
+		t.Errorf("expected the second request to never be sent, got %d in flight", got)
+	}
+}
+
+func TestZeroDisablesLimits(t *testing.T) {
+	handler := newBlockingHandler()
+	c := newTestClient(t, handler.ServeHTTP)
+	c.SetRateLimit(0)
+	c.SetMaxConcurrentRequests(0)
+
+	// More requests at once than the default rate limit burst and
+	// concurrency cap allow
+	const requests = 25
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.ListWebhookSubscriptions(context.Background()); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	allInFlight := waitFor(t, 5*time.Second, func() bool { return handler.inFlight.Load() == requests })
+	close(handler.release)
+	wg.Wait()
+	if !allInFlight {
+		t.Errorf("expected all %d requests in flight at once, got at most %d", requests, handler.peak.Load())
+	}
+}
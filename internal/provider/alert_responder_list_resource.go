@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &alertResponderListResource{}
+	_ list.ListResourceWithConfigure = &alertResponderListResource{}
+)
+
+// NewAlertResponderListResource is a helper function to simplify the provider implementation.
+func NewAlertResponderListResource() list.ListResource {
+	return &alertResponderListResource{}
+}
+
+// alertResponderListResource lists existing alert responders for
+// `terraform query`, so they can be imported and their configuration
+// generated in bulk.
+type alertResponderListResource struct {
+	client *client.Client
+}
+
+// alertResponderListModel maps the list block configuration.
+type alertResponderListModel struct {
+	TeamName   types.String `tfsdk:"team_name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	SourceType types.String `tfsdk:"source_type"`
+}
+
+// Metadata returns the type name of the resource being listed.
+func (r *alertResponderListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_responder"
+}
+
+// ListResourceConfigSchema defines the filters of a list block.
+func (r *alertResponderListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the organization's alert responders. Every filter is optional; without filters, all alert responders are listed.",
+		Attributes: map[string]schema.Attribute{
+			"team_name": schema.StringAttribute{
+				Description: "Only list alert responders of this team",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only list enabled (ACTIVE) alert responders when true, or disabled (PAUSED) ones when false",
+				Optional:    true,
+			},
+			"source_type": schema.StringAttribute{
+				Description: "Only list alert responders with a source of this type (PAGERDUTY, OPSGENIE, FIREHYDRANT, ROOTLY, SLACK)",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PAGERDUTY", "OPSGENIE", "FIREHYDRANT", "ROOTLY", "SLACK"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *alertResponderListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// List streams the alert responders matching the filters, fetching pages
+// only as Terraform consumes them.
+func (r *alertResponderListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config alertResponderListModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	opts := &client.ListAlertRespondersOptions{
+		TeamName:   config.TeamName.ValueString(),
+		SourceType: config.SourceType.ValueString(),
+	}
+	if !config.Enabled.IsNull() {
+		opts.Status = "PAUSED"
+		if config.Enabled.ValueBool() {
+			opts.Status = "ACTIVE"
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for alertResponder, err := range r.client.AlertResponders(ctx, opts) {
+			if err != nil {
+				var diags diag.Diagnostics
+				addAPIErrorDiagnostics(ctx, &diags, nil, "Error Listing Alert Responders", "Could not list alert responders: ", err)
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = alertResponder.TeamName + "/" + alertResponder.Name
+			result.Diagnostics.Append(setAlertResponderIdentity(ctx, result.Identity, &alertResponder)...)
+			if req.IncludeResource {
+				model := alertResponderResourceModel{
+					AdoptExisting:      types.BoolValue(false),
+					RestoreIfDeleted:   types.BoolValue(false),
+					DeletionProtection: types.BoolValue(true),
+					PurgeOnDestroy:     types.BoolValue(false),
+					Timeouts:           timeouts.Value{Object: types.ObjectNull(alertResponderTimeoutsAttrTypes)},
+				}
+				mapAlertResponder(&alertResponder, &model)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+			if !push(result) {
+				return
+			}
+
+			count++
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+// testAccAlertResponderIdentity returns checks for the full identity of an
+// alert responder, as query filters only match complete identities
+func testAccAlertResponderIdentity(ar client.AlertResponder) map[string]knownvalue.Check {
+	return map[string]knownvalue.Check{
+		"organization": knownvalue.StringExact(tierzerotest.OrganizationName),
+		"id":           knownvalue.StringExact(ar.ID),
+		"team_name":    knownvalue.StringExact(ar.TeamName),
+		"name":         knownvalue.StringExact(ar.Name),
+	}
+}
+
+func TestAccAlertResponderListResource(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	channel := "C07TUN1EFFU"
+	webhook := server.PutAlertResponder(client.AlertResponder{
+		TeamName:         "Platform",
+		Name:             "Production Alerts",
+		WebhookSources:   []client.WebhookSource{{Type: "PAGERDUTY", RemoteID: "PABC123"}},
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"critical"}},
+	})
+	slack := server.PutAlertResponder(client.AlertResponder{
+		TeamName:         "Platform",
+		Name:             "Slack Alerts",
+		SlackChannelID:   &channel,
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+		Status:           "PAUSED",
+	})
+	server.PutAlertResponder(client.AlertResponder{
+		TeamName:         "Payments",
+		Name:             "Payment Alerts",
+		WebhookSources:   []client.WebhookSource{{Type: "OPSGENIE", RemoteID: "og-123"}},
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"payment"}},
+	})
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The provider configuration of this step also applies to
+				// the query step
+				Config: server.ProviderConfig(),
+			},
+			// Each query step has a single list block: length checks only
+			// see the summary of the last list block to complete
+			{
+				Query: true,
+				Config: `
+list "tierzero_alert_responder" "all" {
+  provider         = tierzero
+  include_resource = true
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tierzero_alert_responder.all", 3),
+					querycheck.ExpectIdentity("tierzero_alert_responder.all", testAccAlertResponderIdentity(webhook)),
+					querycheck.ExpectResourceDisplayName("tierzero_alert_responder.all", queryfilter.ByResourceIdentity(testAccAlertResponderIdentity(webhook)), knownvalue.StringExact("Platform/Production Alerts")),
+					querycheck.ExpectResourceKnownValues("tierzero_alert_responder.all", queryfilter.ByResourceIdentity(testAccAlertResponderIdentity(slack)), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("slack_channel_id"), KnownValue: knownvalue.StringExact(channel)},
+						{Path: tfjsonpath.New("enabled"), KnownValue: knownvalue.Bool(false)},
+						{Path: tfjsonpath.New("url"), KnownValue: knownvalue.StringExact(slack.URL)},
+					}),
+				},
+			},
+			{
+				Query: true,
+				Config: `
+list "tierzero_alert_responder" "platform" {
+  provider = tierzero
+
+  config {
+    team_name = "Platform"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tierzero_alert_responder.platform", 2),
+				},
+			},
+			{
+				Query: true,
+				Config: `
+list "tierzero_alert_responder" "paused_slack" {
+  provider = tierzero
+
+  config {
+    enabled     = false
+    source_type = "SLACK"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("tierzero_alert_responder.paused_slack", 1),
+					querycheck.ExpectIdentity("tierzero_alert_responder.paused_slack", testAccAlertResponderIdentity(slack)),
+				},
+			},
+		},
+	})
+}
@@ -2,28 +2,70 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
-
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/tierzero/terraform-provider-tierzero/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &alertResponderResource{}
-	_ resource.ResourceWithConfigure   = &alertResponderResource{}
-	_ resource.ResourceWithImportState = &alertResponderResource{}
+	_ resource.Resource                   = &alertResponderResource{}
+	_ resource.ResourceWithConfigure      = &alertResponderResource{}
+	_ resource.ResourceWithImportState    = &alertResponderResource{}
+	_ resource.ResourceWithValidateConfig = &alertResponderResource{}
+	_ resource.ResourceWithModifyPlan     = &alertResponderResource{}
+)
+
+// privateKeyCreateIdempotencyKey is the private state key holding the
+// Idempotency-Key of a create request whose outcome is unknown, so that the
+// next apply sends it again with the same key.
+const privateKeyCreateIdempotencyKey = "create_idempotency_key"
+
+// privateKeyPurgedReplacementID is the private state key of a planned
+// replacement holding the ID of the alert responder being replaced, which
+// purge_on_destroy deletes permanently before its successor takes its name.
+const privateKeyPurgedReplacementID = "purged_replacement_id"
+
+// Default operation timeouts, used unless overridden in the timeouts block.
+// Each covers every API request of the operation, including retries.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
 )
 
+// alertResponderTimeoutsAttrTypes are the attribute types of the timeouts
+// block, needed to build a null block outside of a plan or state
+var alertResponderTimeoutsAttrTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
 // NewAlertResponderResource is a helper function to simplify the provider implementation.
 func NewAlertResponderResource() resource.Resource {
 	return &alertResponderResource{}
@@ -32,6 +74,12 @@ func NewAlertResponderResource() resource.Resource {
 // alertResponderResource is the resource implementation.
 type alertResponderResource struct {
 	client *client.Client
+	// ignoreDeletionProtection is set when the provider configuration turns
+	// deletion protection off
+	ignoreDeletionProtection bool
+	// defaultRunbook is shared by every alert responder of the provider
+	// instance
+	defaultRunbook *defaultRunbookCache
 }
 
 // alertResponderResourceModel maps the resource schema data.
@@ -45,9 +93,14 @@ type alertResponderResourceModel struct {
 	Runbook                    *runbookModel                  `tfsdk:"runbook"`
 	NotificationIntegrationIDs []types.String                 `tfsdk:"notification_integration_ids"`
 	Enabled                    types.Bool                     `tfsdk:"enabled"`
+	AdoptExisting              types.Bool                     `tfsdk:"adopt_existing"`
+	RestoreIfDeleted           types.Bool                     `tfsdk:"restore_if_deleted"`
+	DeletionProtection         types.Bool                     `tfsdk:"deletion_protection"`
+	PurgeOnDestroy             types.Bool                     `tfsdk:"purge_on_destroy"`
 	URL                        types.String                   `tfsdk:"url"`
 	CreatedAt                  types.String                   `tfsdk:"created_at"`
 	UpdatedAt                  types.String                   `tfsdk:"updated_at"`
+	Timeouts                   timeouts.Value                 `tfsdk:"timeouts"`
 }
 
 type webhookSourceModel struct {
@@ -68,12 +121,15 @@ type runbookModel struct {
 // Metadata returns the resource type name.
 func (r *alertResponderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert_responder"
+	// The identity includes the name, which can be changed in place
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema defines the schema for the resource.
-func (r *alertResponderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *alertResponderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a TierZero Alert Responder that automatically investigates incoming alerts.",
+		Version:     alertResponderSchemaVersion,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Alert Responder Global ID",
@@ -93,11 +149,18 @@ func (r *alertResponderResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "Alert responder name",
 				Required:    true,
 			},
-			"webhook_sources": schema.ListNestedAttribute{
-				Description: "Webhook sources to monitor (for PagerDuty, OpsGenie, FireHydrant, Rootly). Mutually exclusive with slack_channel_id. Changing this field requires resource replacement.",
+			"webhook_sources": schema.SetNestedAttribute{
+				Description: "Webhook sources to monitor (for PagerDuty, OpsGenie, FireHydrant, Rootly). Mutually exclusive with slack_channel_id. Sources can be added or removed in place; switching to or from slack_channel_id requires resource replacement.",
 				Optional:    true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfSourceModeChanged,
+						"Switching between webhook_sources and slack_channel_id requires resource replacement.",
+						"Switching between `webhook_sources` and `slack_channel_id` requires resource replacement.",
+					),
 				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -118,6 +181,9 @@ func (r *alertResponderResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"slack_channel_id": schema.StringAttribute{
 				Description: "Slack channel ID (e.g., 'C01234567' for public channels, 'G01234567' for private channels). Mutually exclusive with webhook_sources. Changing this field requires resource replacement.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -126,55 +192,100 @@ func (r *alertResponderResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "Criteria for matching alerts",
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
-					"text_matches": schema.ListAttribute{
+					"text_matches": schema.SetAttribute{
 						Description: "Array of text patterns to match",
 						Required:    true,
 						ElementType: types.StringType,
 					},
 					"slack_bot_app_user_id": schema.StringAttribute{
-						Description: "Optional Slack bot/sender app user ID to filter messages (only for Slack alerts)",
+						Description: "Optional Slack bot/sender app user ID to filter messages (only for Slack alerts, requires slack_channel_id)",
 						Optional:    true,
 					},
 				},
 			},
 			"runbook": schema.SingleNestedAttribute{
-				Description: "Investigation runbook (optional, uses default if not provided)",
+				Description: "Investigation runbook. Prompts that are not set use the organization's default runbook, available from the tierzero_default_runbook data source, and follow it when it changes.",
 				Optional:    true,
+				Computed:    true,
 				Attributes: map[string]schema.Attribute{
 					"investigation_prompt": schema.StringAttribute{
-						Description: "Main investigation prompt",
+						Description: "Main investigation prompt (defaults to the organization's default investigation prompt)",
 						Optional:    true,
+						Computed:    true,
 					},
 					"impact_and_severity_prompt": schema.StringAttribute{
-						Description: "Quick triage prompt for impact and severity analysis",
+						Description: "Quick triage prompt for impact and severity analysis (defaults to the organization's default impact and severity prompt)",
 						Optional:    true,
+						Computed:    true,
 					},
 				},
 			},
-			"notification_integration_ids": schema.ListAttribute{
+			"notification_integration_ids": schema.SetAttribute{
 				Description: "Notification integration Global IDs",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
 			"enabled": schema.BoolAttribute{
-				Description: "Whether the alert responder is enabled. When true, status is ACTIVE. When false, status is PAUSED. Uses enable/disable API endpoints under the hood.",
+				Description: "Whether the alert responder is enabled. When true, status is ACTIVE. When false, status is PAUSED; a disabled responder is created PAUSED and never runs as ACTIVE. Later changes use the enable/disable API endpoints.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Whether to take over an existing alert responder with the same team_name and name instead of failing. The existing alert responder is updated to match the configuration, and is deleted when this resource is destroyed. Only attributes that force replacement, such as slack_channel_id, must already match. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"restore_if_deleted": schema.BoolAttribute{
+				Description: "Whether to restore a deleted alert responder with the same team_name and name instead of failing. Deleted alert responders keep holding their name until they are purged. The restored alert responder is updated to match the configuration; only attributes that force replacement, such as slack_channel_id, must already match. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether Terraform is prevented from deleting the alert responder, including when replacing it. Set to false and apply before destroying it. Defaults to true. The provider's deletion_protection = false overrides it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"purge_on_destroy": schema.BoolAttribute{
+				Description: "Whether destroying the alert responder deletes it permanently, freeing its name, instead of soft deleting it. A purged alert responder cannot be restored. The value in state when destroying applies, so set it and apply before destroying. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"url": schema.StringAttribute{
 				Description: "Link to alert responder details page (returned by create/update operations)",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "Creation timestamp (ISO 8601)",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
 				Description: "Last update timestamp (ISO 8601)",
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Read:              true,
+				Update:            true,
+				Delete:            true,
+				CreateDescription: "Time allowed to create the alert responder, including retries, as a duration string such as \"30s\" or \"2h45m\". Defaults to 10m.",
+				ReadDescription:   "Time allowed to refresh the alert responder, including retries. Defaults to 5m.",
+				UpdateDescription: "Time allowed to update the alert responder, including retries. Defaults to 10m.",
+				DeleteDescription: "Time allowed to delete the alert responder, including retries. Defaults to 10m.",
+			}),
+		},
 	}
 }
 
@@ -184,101 +295,435 @@ func (r *alertResponderResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.ignoreDeletionProtection = data.IgnoreDeletionProtection
+	r.defaultRunbook = data.DefaultRunbook
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *alertResponderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan alertResponderResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+// ValidateConfig checks the rules on alert sources during validate and plan,
+// before any API request is made.
+func (r *alertResponderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var webhookSources types.Set
+	var slackChannelID, slackBotAppUserID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook_sources"), &webhookSources)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slack_channel_id"), &slackChannelID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("matching_criteria").AtName("slack_bot_app_user_id"), &slackBotAppUserID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Validate that either webhook_sources OR slack_channel_id is provided (not both, not neither)
-	hasWebhookSources := len(plan.WebhookSources) > 0
-	hasSlackChannelID := !plan.SlackChannelID.IsNull() && !plan.SlackChannelID.IsUnknown() && plan.SlackChannelID.ValueString() != ""
+	// Values from variables or other resources may not be known until apply
+	if webhookSources.IsUnknown() || slackChannelID.IsUnknown() {
+		return
+	}
+
+	// Either webhook_sources OR slack_channel_id must be provided (not both, not neither)
+	hasWebhookSources := !webhookSources.IsNull()
+	hasSlackChannelID := !slackChannelID.IsNull()
 
 	if !hasWebhookSources && !hasSlackChannelID {
-		resp.Diagnostics.AddError(
-			"Invalid Configuration",
+		resp.Diagnostics.AddAttributeError(
+			path.Root("webhook_sources"),
+			"Missing Alert Source",
 			"Must specify either webhook_sources or slack_channel_id",
 		)
 		return
 	}
 
 	if hasWebhookSources && hasSlackChannelID {
-		resp.Diagnostics.AddError(
-			"Invalid Configuration",
+		resp.Diagnostics.AddAttributeError(
+			path.Root("slack_channel_id"),
+			"Conflicting Alert Sources",
 			"Cannot specify both webhook_sources and slack_channel_id. These are mutually exclusive.",
 		)
 		return
 	}
 
-	// Build the create request
-	createReq := &client.CreateAlertResponderRequest{
-		TeamName:         plan.TeamName.ValueString(),
-		Name:             plan.Name.ValueString(),
-		MatchingCriteria: buildMatchingCriteria(plan.MatchingCriteria),
+	if !hasSlackChannelID && !slackBotAppUserID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("matching_criteria").AtName("slack_bot_app_user_id"),
+			"Invalid Attribute Combination",
+			"slack_bot_app_user_id filters Slack messages and can only be set together with slack_channel_id.",
+		)
 	}
+}
 
-	// Set webhook_sources or slack_channel_id
-	if hasWebhookSources {
-		createReq.WebhookSources = buildWebhookSources(plan.WebhookSources)
+// ModifyPlan plans the runbook prompts left to the default runbook, and
+// reports a name already taken in the team at plan time, before anything is
+// changed: a responder created outside this resource, for example by hand or
+// by another workspace, is only taken over when adopt_existing allows it, and
+// a deleted one holding the name is only restored when restore_if_deleted
+// allows it.
+func (r *alertResponderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
 	}
-	if hasSlackChannelID {
-		slackChannelID := plan.SlackChannelID.ValueString()
-		createReq.SlackChannelID = &slackChannelID
+
+	r.planRunbook(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if plan.Runbook != nil {
-		createReq.Runbook = buildRunbook(plan.Runbook)
+	if !req.State.Raw.IsNull() {
+		var priorID types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &priorID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if priorID.ValueString() == "" {
+			planPendingCreate(ctx, resp)
+			return
+		}
 	}
 
-	if len(plan.NotificationIntegrationIDs) > 0 {
-		createReq.NotificationIntegrationIDs = buildStringList(plan.NotificationIntegrationIDs)
+	var teamName, name types.String
+	var adoptExisting, restoreIfDeleted types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("team_name"), &teamName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("adopt_existing"), &adoptExisting)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("restore_if_deleted"), &restoreIfDeleted)...)
+	if resp.Diagnostics.HasError() || teamName.IsUnknown() || name.IsUnknown() {
+		return
 	}
 
-	// Create the alert responder
-	alertResponder, err := r.client.CreateAlertResponder(ctx, createReq)
+	// Terraform plans a replacement twice: first against the prior state,
+	// then as a create without it, which checks the name in the new team
+	if req.State.Raw.IsNull() {
+		// The responder being replaced holds the name until destroy purges it
+		replacedID, diags := getPrivateString(ctx, req.Private, privateKeyPurgedReplacementID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.checkNameAvailable(ctx, teamName, name, types.StringNull(), replacedID, adoptExisting.ValueBool(), restoreIfDeleted.ValueBool(), resp)
+		return
+	}
+
+	var id, stateTeamName, stateName, slackChannelID, stateSlackChannelID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("team_name"), &stateTeamName)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("slack_channel_id"), &stateSlackChannelID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("slack_channel_id"), &slackChannelID)...)
+	if resp.Diagnostics.HasError() || !teamName.Equal(stateTeamName) || slackChannelID.IsUnknown() {
+		return
+	}
+
+	// A change of slack_channel_id, including a switch to or from
+	// webhook_sources, replaces the responder
+	replacing := !slackChannelID.Equal(stateSlackChannelID)
+	switch {
+	case !name.Equal(stateName) && !replacing:
+		r.checkNameAvailable(ctx, teamName, name, id, "", false, false, resp)
+	case name.Equal(stateName) && replacing:
+		// With purge_on_destroy, destroying the responder being replaced
+		// frees its name for the new one. Create still refuses to run while
+		// it holds the name, as it does with create_before_destroy.
+		var purgeOnDestroy types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("purge_on_destroy"), &purgeOnDestroy)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if purgeOnDestroy.ValueBool() {
+			resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, privateKeyPurgedReplacementID, id.ValueString())...)
+			return
+		}
+
+		// Otherwise a deleted responder keeps holding its name, or would be
+		// restored instead of creating a new one
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Alert Responder Replacement Needs a New Name",
+			fmt.Sprintf("Changing slack_channel_id, or switching between webhook_sources and slack_channel_id, replaces alert responder %s. "+
+				"Alert responder names are unique within a team and the responder being replaced keeps holding %q after it is deleted, "+
+				"so change name as well, or apply `purge_on_destroy = true` before replacing it.",
+				id.ValueString(), name.ValueString()),
+		)
+	}
+}
+
+// planPendingCreate plans the update completing a pending create, saved by
+// savePendingCreate. The name is not checked: sending the create request
+// again returns the responder it may have created already.
+func planPendingCreate(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	for _, attribute := range []string{"id", "url", "created_at", "updated_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+	resp.Diagnostics.AddWarning(
+		"Alert Responder Creation Will Be Completed",
+		"An earlier apply could not confirm that this alert responder was created. This apply sends the create request again with the same Idempotency-Key, "+
+			"which returns the alert responder if it was created and creates it otherwise.",
+	)
+}
+
+// planRunbook sets the planned runbook prompts that are not configured to the
+// organization's default runbook. The API fills them in the same way on every
+// write, so a prompt removed from the configuration is reset to the default,
+// and a changed default is planned as an update. The default runbook is read
+// once per provider instance.
+func (r *alertResponderResource) planRunbook(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configObject types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("runbook"), &configObject)...)
+	if resp.Diagnostics.HasError() || configObject.IsUnknown() {
+		return
+	}
+
+	var configured *runbookModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("runbook"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configured != nil && !configured.InvestigationPrompt.IsNull() && !configured.ImpactAndSeverityPrompt.IsNull() {
+		return
+	}
+
+	defaults, err := r.defaultRunbook.get(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Alert Responder",
-			"Could not create alert responder: "+err.Error(),
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Reading Default Runbook", "Could not read the default runbook: ", err)
+		return
+	}
+	planned := withDefaultPrompts(configured, defaults)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("runbook"), planned)...)
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Terraform only marks updated_at unknown when the configuration changed,
+	// not when the default runbook did
+	var prior *runbookModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("runbook"), &prior)...)
+	if !resp.Diagnostics.HasError() && runbookChanged(planned, prior) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+	}
+}
+
+// checkNameAvailable reports at plan time whether the name of the alert
+// responder with the given id, null when it is being created, is used by
+// another responder of the team, live or deleted. replacedID, when not empty,
+// is a responder being replaced that destroy purges first.
+func (r *alertResponderResource) checkNameAvailable(ctx context.Context, teamName, name, id types.String, replacedID string, adoptExisting, restoreIfDeleted bool, resp *resource.ModifyPlanResponse) {
+	creating := id.IsNull()
+
+	matches, err := r.listAlertRespondersNamed(ctx, teamName.ValueString(), name.ValueString())
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Checking Alert Responder Name", "Could not list alert responders: ", err)
+		return
+	}
+	matches = slices.DeleteFunc(matches, func(alertResponder client.AlertResponder) bool {
+		return alertResponder.ID == id.ValueString() || alertResponder.ID == replacedID
+	})
+
+	switch {
+	case len(matches) == 0:
+		r.checkNameNotHeldByDeleted(ctx, teamName, name, id, restoreIfDeleted, resp)
+	case creating && adoptExisting && len(matches) == 1:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("name"),
+			"Existing Alert Responder Will Be Adopted",
+			fmt.Sprintf("Alert responder %s, named %q in team %q, will be updated to match this configuration and managed by Terraform. Destroying this resource will delete it.",
+				matches[0].ID, name.ValueString(), teamName.ValueString()),
+		)
+	case creating:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Alert Responder Already Exists",
+			fmt.Sprintf("%d alert responder(s) named %q already exist in team %q: %s. Alert responder names are unique within a team.\n\n"+
+				"Set `adopt_existing = true` to take over the existing alert responder, import it with `terraform import`, or choose a different name. "+
+				"If this resource is being replaced, for example because it is tainted, the alert responder being replaced holds the name: change name as well.",
+				len(matches), name.ValueString(), teamName.ValueString(), alertResponderIDs(matches)),
+		)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Alert Responder Name Already Taken",
+			fmt.Sprintf("Alert responder %s cannot be renamed to %q: the name is already used in team %q by %s. Alert responder names are unique within a team.",
+				id.ValueString(), name.ValueString(), teamName.ValueString(), alertResponderIDs(matches)),
 		)
+	}
+}
+
+// checkNameNotHeldByDeleted reports at plan time whether the name of the
+// alert responder with the given id, null when it is being created, is held
+// by a deleted responder of the team, which keeps it until it is purged.
+func (r *alertResponderResource) checkNameNotHeldByDeleted(ctx context.Context, teamName, name, id types.String, restoreIfDeleted bool, resp *resource.ModifyPlanResponse) {
+	creating := id.IsNull()
+
+	deleted, err := r.listDeletedAlertRespondersNamed(ctx, teamName.ValueString(), name.ValueString())
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Checking Alert Responder Name", "Could not list deleted alert responders: ", err)
 		return
 	}
 
-	// If enabled is false, disable the alert responder
-	if !plan.Enabled.ValueBool() {
-		_, err = r.client.DisableAlertResponder(ctx, alertResponder.ID)
+	switch {
+	case len(deleted) == 0:
+		return
+	case creating && restoreIfDeleted && len(deleted) == 1:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("name"),
+			"Deleted Alert Responder Will Be Restored",
+			fmt.Sprintf("Alert responder %s, named %q in team %q, was deleted at %s. It will be restored, updated to match this configuration and managed by Terraform instead of creating a new one.",
+				deleted[0].ID, name.ValueString(), teamName.ValueString(), deleted[0].DeletedAt),
+		)
+	case creating:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Alert Responder Name Held by a Deleted Responder",
+			fmt.Sprintf("The name %q is held in team %q by deleted alert responder(s) %s. Deleted alert responders keep holding their name until they are purged.\n\n"+
+				"Set `restore_if_deleted = true` to restore the deleted alert responder and manage it with Terraform, or choose a different name.",
+				name.ValueString(), teamName.ValueString(), alertResponderIDs(deleted)),
+		)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Alert Responder Name Already Taken",
+			fmt.Sprintf("Alert responder %s cannot be renamed to %q: the name is held in team %q by deleted alert responder(s) %s, which keep it until they are purged.",
+				id.ValueString(), name.ValueString(), teamName.ValueString(), alertResponderIDs(deleted)),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertResponderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertResponderResourceModel
+	var configRunbook *runbookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("runbook"), &configRunbook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createReq := buildCreateRequest(&plan, configRunbook)
+
+	// Refuse to create over a live responder holding the name. With
+	// create_before_destroy it is the very responder being replaced: an API
+	// returning it as the "new" one would have it deleted right after.
+	existing, err := r.listAlertRespondersNamed(ctx, createReq.TeamName, createReq.Name)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Creating Alert Responder", "Could not check for an existing alert responder: ", err)
+		return
+	}
+	if len(existing) == 1 && plan.AdoptExisting.ValueBool() {
+		r.adoptAlertResponder(ctx, plan, configRunbook, &existing[0], resp)
+		return
+	}
+	if len(existing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Alert Responder Already Exists",
+			alertResponderExistsDetail(createReq.TeamName, createReq.Name, existing[0].ID),
+		)
+		return
+	}
+
+	// A deleted responder keeps holding the name until it is purged
+	if plan.RestoreIfDeleted.ValueBool() {
+		deleted, err := r.listDeletedAlertRespondersNamed(ctx, createReq.TeamName, createReq.Name)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Disabling Alert Responder",
-				"Alert responder was created but could not be disabled: "+err.Error(),
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Creating Alert Responder", "Could not check for a deleted alert responder: ", err)
+			return
+		}
+		if len(deleted) == 1 {
+			r.restoreAlertResponder(ctx, plan, configRunbook, &deleted[0], resp)
+			return
+		}
+	}
+
+	// The idempotency key is reused by every retry of this request, so a
+	// create interrupted by a transient failure never produces a second
+	// responder, and a responder with the same name created outside this
+	// request is reported as a conflict rather than silently adopted.
+	idempotencyKey, err := client.NewIdempotencyKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Alert Responder", err.Error())
+		return
+	}
+	createReq.IdempotencyKey = idempotencyKey
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, privateKeyCreateIdempotencyKey, idempotencyKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the alert responder
+	alertResponder, err := r.client.CreateAlertResponder(ctx, createReq)
+	if err != nil {
+		if createOutcomeUnknown(err) {
+			savePendingCreate(ctx, plan, &resp.State, resp.Identity, &resp.Diagnostics, err)
+			return
+		}
+		if client.IsConflict(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Alert Responder Already Exists",
+				alertResponderExistsDetail(createReq.TeamName, createReq.Name, "")+"\n\n"+err.Error(),
 			)
 			return
 		}
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error Creating Alert Responder", "Could not create alert responder: ", err)
+		return
+	}
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, privateKeyCreateIdempotencyKey, "")...)
+
+	fullAlertResponder, err := r.completeCreate(ctx, &plan, createReq, alertResponder, &resp.Diagnostics)
+	if err != nil {
+		// The responder does not match the plan, so the error marks it tainted
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Disabling Alert Responder",
+			fmt.Sprintf("Alert responder %s was created but could not be disabled, so it is saved to state as tainted. "+
+				"Replacing it fails while its deletion_protection is enabled, and a replacement cannot reuse its name: "+
+				"run `terraform untaint` on the resource and apply again to disable it instead. Error: ", alertResponder.ID), err)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, fullAlertResponder)...)
+}
+
+// completeCreate finishes creating an alert responder once the create request
+// succeeded, updating plan with what the server holds. It disables the
+// responder if the API ignored the PAUSED status requested, returning the
+// error when that fails, then reads it back. The responder is already created
+// as planned, so a failed read only warns: failing would taint it, and a
+// tainted responder cannot be replaced under its own name.
+func (r *alertResponderResource) completeCreate(ctx context.Context, plan *alertResponderResourceModel, createReq *client.CreateAlertResponderRequest, alertResponder *client.AlertResponder, diags *diag.Diagnostics) (*client.AlertResponder, error) {
+	// From here on the responder exists, so it is saved to state even when a
+	// later step fails, rather than being left untracked
+	plan.ID = types.StringValue(alertResponder.ID)
+	plan.URL = types.StringValue(alertResponder.URL)
+	plan.CreatedAt = types.StringValue(alertResponder.CreatedAt)
+	plan.UpdatedAt = types.StringValue(alertResponder.UpdatedAt)
+	plan.Enabled = types.BoolValue(alertResponder.Status == "ACTIVE")
+
+	if createReq.Status == "PAUSED" && alertResponder.Status != "PAUSED" {
+		if _, err := r.client.DisableAlertResponder(ctx, alertResponder.ID); err != nil {
+			return alertResponder, err
+		}
 		alertResponder.Status = "PAUSED"
 	}
 
 	// Read back to get full details
 	fullAlertResponder, err := r.client.GetAlertResponder(ctx, alertResponder.ID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Alert Responder",
-			"Could not read alert responder after creation: "+err.Error(),
+		diags.AddWarning(
+			"Unable to Read Alert Responder",
+			fmt.Sprintf("Alert responder %s was created but could not be read back, so its state is taken from the create response until the next refresh: %s", alertResponder.ID, err),
 		)
-		return
+		fullAlertResponder = alertResponder
 	}
 
 	// Preserve URL from create response (GET doesn't return it)
@@ -290,8 +735,203 @@ func (r *alertResponderResource) Create(ctx context.Context, req resource.Create
 	plan.CreatedAt = types.StringValue(fullAlertResponder.CreatedAt)
 	plan.UpdatedAt = types.StringValue(fullAlertResponder.UpdatedAt)
 	plan.Enabled = types.BoolValue(fullAlertResponder.Status == "ACTIVE")
+	return fullAlertResponder, nil
+}
+
+// createOutcomeUnknown reports whether a failed create request may still have
+// created the alert responder: the API answered with a server error, or did
+// not answer at all.
+func createOutcomeUnknown(err error) bool {
+	apiErr, ok := client.AsAPIError(err)
+	return !ok || apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+// savePendingCreate saves an alert responder whose create request failed
+// without telling whether it was created. Its state has an empty ID, and the
+// Idempotency-Key of the request stays in private state: the next apply plans
+// an update that sends the request again with the same key, which returns the
+// responder if it was created and creates it otherwise. The apply only warns,
+// since an error would taint the resource, and replacing it would lose the key.
+func savePendingCreate(ctx context.Context, plan alertResponderResourceModel, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics, err error) {
+	plan.ID = types.StringValue("")
+	plan.URL = types.StringValue("")
+	plan.CreatedAt = types.StringValue("")
+	plan.UpdatedAt = types.StringValue("")
+
+	diags.AddWarning(
+		"Alert Responder Creation Not Confirmed",
+		fmt.Sprintf("The request creating alert responder %q in team %q failed, so it may or may not have been created: %s\n\n"+
+			"The alert responder is saved to state as pending creation, without an ID. The next apply sends the request again "+
+			"with the same Idempotency-Key, which completes the creation without ever producing a second responder.",
+			plan.Name.ValueString(), plan.TeamName.ValueString(), err),
+	)
+	diags.Append(state.Set(ctx, plan)...)
+	diags.Append(setAlertResponderIdentity(ctx, identity, &client.AlertResponder{
+		TeamName: plan.TeamName.ValueString(),
+		Name:     plan.Name.ValueString(),
+	})...)
+}
+
+// isPendingCreate reports whether state was saved by savePendingCreate
+func isPendingCreate(state alertResponderResourceModel) bool {
+	return state.ID.ValueString() == ""
+}
+
+// privateState is implemented by the private state of requests and responses
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setPrivateString stores a string in private state, or removes the key when
+// value is empty
+func setPrivateString(ctx context.Context, private privateState, key, value string) diag.Diagnostics {
+	if value == "" {
+		return private.SetKey(ctx, key, nil)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error Saving Private State", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, key, encoded)
+}
+
+// getPrivateString returns a string stored by setPrivateString, or "" when
+// there is none
+func getPrivateString(ctx context.Context, private privateState, key string) (string, diag.Diagnostics) {
+	encoded, diags := private.GetKey(ctx, key)
+	if diags.HasError() || len(encoded) == 0 {
+		return "", diags
+	}
+	var value string
+	if err := json.Unmarshal(encoded, &value); err != nil {
+		diags.AddError("Error Reading Private State", err.Error())
+	}
+	return value, diags
+}
+
+// restoreAlertResponder restores a deleted alert responder holding the
+// planned name for Create, then takes it over like adoptAlertResponder.
+func (r *alertResponderResource) restoreAlertResponder(ctx context.Context, plan alertResponderResourceModel, configRunbook *runbookModel, deleted *client.AlertResponder, resp *resource.CreateResponse) {
+	if !checkCanTakeOver(plan, deleted, "Alert Responder Cannot Be Restored", &resp.Diagnostics) {
+		return
+	}
+
+	restored, err := r.client.RestoreAlertResponder(ctx, deleted.ID)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Restoring Alert Responder", "Could not restore the deleted alert responder: ", err)
+		return
+	}
+
+	r.takeOverAlertResponder(ctx, plan, configRunbook, restored, "Error Restoring Alert Responder", "restored", resp)
+}
+
+// adoptAlertResponder takes over an existing alert responder for Create,
+// updating it to match the plan.
+func (r *alertResponderResource) adoptAlertResponder(ctx context.Context, plan alertResponderResourceModel, configRunbook *runbookModel, existing *client.AlertResponder, resp *resource.CreateResponse) {
+	if !checkCanTakeOver(plan, existing, "Alert Responder Cannot Be Adopted", &resp.Diagnostics) {
+		return
+	}
+
+	r.takeOverAlertResponder(ctx, plan, configRunbook, existing, "Error Adopting Alert Responder", "adopted", resp)
+}
+
+// takeOverAlertResponder updates an adopted or restored alert responder to
+// match the plan. The responder is saved to state before it is updated: when
+// a step fails, the state holds what the server has after the steps that
+// succeeded, and the error marks it tainted rather than leaving it untracked.
+func (r *alertResponderResource) takeOverAlertResponder(ctx context.Context, plan alertResponderResourceModel, configRunbook *runbookModel, existing *client.AlertResponder, summary, takenOver string, resp *resource.CreateResponse) {
+	var current alertResponderResourceModel
+	mapAlertResponder(existing, &current)
+
+	// applied tracks what the server holds after each successful step, along
+	// with the attributes that only exist in Terraform
+	applied := current
+	applied.AdoptExisting = plan.AdoptExisting
+	applied.RestoreIfDeleted = plan.RestoreIfDeleted
+	applied.DeletionProtection = plan.DeletionProtection
+	applied.PurgeOnDestroy = plan.PurgeOnDestroy
+	applied.Timeouts = plan.Timeouts
+	saveAppliedState := func(alertResponder *client.AlertResponder, detail string, err error) {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, summary,
+			fmt.Sprintf("Alert responder %s was %s but %s, so it is saved to state as tainted. "+
+				"Replacing it would delete it: run `terraform untaint` on the resource and apply again to finish updating it instead. Error: ", existing.ID, takenOver, detail), err)
+		resp.Diagnostics.Append(resp.State.Set(ctx, applied)...)
+		resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, alertResponder)...)
+	}
+
+	id := existing.ID
+	if !plan.Enabled.Equal(current.Enabled) {
+		var err error
+		if plan.Enabled.ValueBool() {
+			_, err = r.client.EnableAlertResponder(ctx, id)
+		} else {
+			_, err = r.client.DisableAlertResponder(ctx, id)
+		}
+		if err != nil {
+			saveAppliedState(existing, "its status could not be changed", err)
+			return
+		}
+		applied.Enabled = plan.Enabled
+	}
+
+	if updateReq := buildUpdateRequest(&plan, &current, configRunbook); updateReq != nil {
+		updated, err := r.client.UpdateAlertResponder(ctx, id, updateReq)
+		if err != nil {
+			saveAppliedState(existing, "it could not be updated", err)
+			return
+		}
+		if updated.URL != "" {
+			current.URL = types.StringValue(updated.URL)
+		}
+	}
+
+	// Every change is made by now, so a failed read only warns, as it does
+	// for a created responder
+	fullAlertResponder, err := r.client.GetAlertResponder(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Alert Responder",
+			fmt.Sprintf("Alert responder %s was %s but could not be read back, so its state is taken from the plan until the next refresh: %s", id, takenOver, err),
+		)
+		fullAlertResponder = existing
+		if plan.Enabled.ValueBool() {
+			fullAlertResponder.Status = "ACTIVE"
+		} else {
+			fullAlertResponder.Status = "PAUSED"
+		}
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.URL = current.URL
+	plan.CreatedAt = types.StringValue(fullAlertResponder.CreatedAt)
+	plan.UpdatedAt = types.StringValue(fullAlertResponder.UpdatedAt)
+	plan.Enabled = types.BoolValue(fullAlertResponder.Status == "ACTIVE")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, fullAlertResponder)...)
+}
+
+// checkCanTakeOver reports whether an existing alert responder can be adopted
+// or restored to match the plan: attributes forcing replacement cannot be
+// changed on it.
+func checkCanTakeOver(plan alertResponderResourceModel, existing *client.AlertResponder, summary string, diags *diag.Diagnostics) bool {
+	var current alertResponderResourceModel
+	mapAlertResponder(existing, &current)
+
+	if !plan.SlackChannelID.Equal(current.SlackChannelID) {
+		diags.AddAttributeError(
+			path.Root("slack_channel_id"),
+			summary,
+			fmt.Sprintf("The existing alert responder %s named %q has slack_channel_id %s, which cannot be changed without replacing it. "+
+				"Set slack_channel_id or webhook_sources to match it, or choose a different name.",
+				existing.ID, existing.Name, current.SlackChannelID),
+		)
+		return false
+	}
+	return true
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -302,6 +942,19 @@ func (r *alertResponderResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// There is nothing to read until the pending create is completed
+	if isPendingCreate(state) {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get current alert responder
 	alertResponder, err := r.client.GetAlertResponder(ctx, state.ID.ValueString())
 	if err != nil {
@@ -310,38 +963,28 @@ func (r *alertResponderResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error Reading Alert Responder",
-			"Could not read alert responder: "+err.Error(),
-		)
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Reading Alert Responder", "Could not read alert responder: ", err)
 		return
 	}
 
 	// Update state from API response
-	state.TeamName = types.StringValue(alertResponder.TeamName)
-	state.Name = types.StringValue(alertResponder.Name)
-	state.WebhookSources = mapWebhookSources(alertResponder.WebhookSources)
-
-	// Handle slack_channel_id
-	if alertResponder.SlackChannelID != nil && *alertResponder.SlackChannelID != "" {
-		state.SlackChannelID = types.StringValue(*alertResponder.SlackChannelID)
-	} else {
-		state.SlackChannelID = types.StringNull()
+	mapAlertResponder(alertResponder, &state)
+	// State written before these attributes were added has no value for them
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
 	}
-
-	state.MatchingCriteria = mapMatchingCriteria(alertResponder.MatchingCriteria)
-	state.Runbook = mapRunbook(alertResponder.Runbook)
-	state.NotificationIntegrationIDs = mapStringList(alertResponder.NotificationIntegrationIDs)
-	state.Enabled = types.BoolValue(alertResponder.Status == "ACTIVE")
-	state.CreatedAt = types.StringValue(alertResponder.CreatedAt)
-	state.UpdatedAt = types.StringValue(alertResponder.UpdatedAt)
-
-	// Preserve URL if not returned by API (GET doesn't return it)
-	if alertResponder.URL != "" {
-		state.URL = types.StringValue(alertResponder.URL)
+	if state.RestoreIfDeleted.IsNull() {
+		state.RestoreIfDeleted = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(true)
+	}
+	if state.PurgeOnDestroy.IsNull() {
+		state.PurgeOnDestroy = types.BoolValue(false)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, alertResponder)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -355,111 +998,152 @@ func (r *alertResponderResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	id := state.ID.ValueString()
-
-	// Validate that either webhook_sources OR slack_channel_id is provided (not both, not neither)
-	hasWebhookSources := len(plan.WebhookSources) > 0
-	hasSlackChannelID := !plan.SlackChannelID.IsNull() && !plan.SlackChannelID.IsUnknown() && plan.SlackChannelID.ValueString() != ""
-
-	if !hasWebhookSources && !hasSlackChannelID {
-		resp.Diagnostics.AddError(
-			"Invalid Configuration",
-			"Must specify either webhook_sources or slack_channel_id",
-		)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	if hasWebhookSources && hasSlackChannelID {
-		resp.Diagnostics.AddError(
-			"Invalid Configuration",
-			"Cannot specify both webhook_sources and slack_channel_id. These are mutually exclusive.",
-		)
+	if isPendingCreate(state) {
+		r.completePendingCreate(ctx, req, plan, resp)
 		return
 	}
 
+	id := state.ID.ValueString()
+
+	// applied tracks what the server holds after each successful step. When a
+	// later step fails it is saved instead of the prior state, so the changes
+	// already made are not planned again.
+	applied := state
+	applied.Timeouts = plan.Timeouts
+	saveAppliedState := func(alertResponder *client.AlertResponder) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, applied)...)
+		if alertResponder != nil {
+			resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, alertResponder)...)
+		}
+	}
+	var updated *client.AlertResponder
+
 	// Handle enabled field changes first
 	if !plan.Enabled.Equal(state.Enabled) {
+		var err error
 		if plan.Enabled.ValueBool() {
-			_, err := r.client.EnableAlertResponder(ctx, id)
+			updated, err = r.client.EnableAlertResponder(ctx, id)
 			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Enabling Alert Responder",
-					"Could not enable alert responder: "+err.Error(),
-				)
+				addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Enabling Alert Responder", "Could not enable alert responder: ", err)
 				return
 			}
 		} else {
-			_, err := r.client.DisableAlertResponder(ctx, id)
+			updated, err = r.client.DisableAlertResponder(ctx, id)
 			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Disabling Alert Responder",
-					"Could not disable alert responder: "+err.Error(),
-				)
+				addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Disabling Alert Responder", "Could not disable alert responder: ", err)
 				return
 			}
 		}
+		applied.Enabled = plan.Enabled
+		applied.UpdatedAt = types.StringValue(updated.UpdatedAt)
 	}
 
-	// Check if other fields changed
-	// Note: team_name, webhook_sources, and slack_channel_id are not included because they have RequiresReplace() plan modifiers
-	needsUpdate := !plan.Name.Equal(state.Name) ||
-		matchingCriteriaChanged(plan.MatchingCriteria, state.MatchingCriteria) ||
-		runbookChanged(plan.Runbook, state.Runbook) ||
-		notificationIDsChanged(plan.NotificationIntegrationIDs, state.NotificationIntegrationIDs)
-
-	if needsUpdate {
-		// Build update request
-		updateReq := &client.UpdateAlertResponderRequest{}
-
-		if !plan.Name.Equal(state.Name) {
-			name := plan.Name.ValueString()
-			updateReq.Name = &name
-		}
-
-		if matchingCriteriaChanged(plan.MatchingCriteria, state.MatchingCriteria) {
-			updateReq.MatchingCriteria = buildMatchingCriteria(plan.MatchingCriteria)
-		}
-
-		if runbookChanged(plan.Runbook, state.Runbook) {
-			updateReq.Runbook = buildRunbook(plan.Runbook)
-		}
-
-		if notificationIDsChanged(plan.NotificationIntegrationIDs, state.NotificationIntegrationIDs) {
-			updateReq.NotificationIntegrationIDs = buildStringList(plan.NotificationIntegrationIDs)
+	var configRunbook *runbookModel
+	if runbookChanged(plan.Runbook, state.Runbook) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("runbook"), &configRunbook)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+	}
 
+	// Note: team_name and slack_channel_id are not compared because they have RequiresReplace() plan modifiers
+	if updateReq := buildUpdateRequest(&plan, &state, configRunbook); updateReq != nil {
 		// Update the alert responder
 		alertResponder, err := r.client.UpdateAlertResponder(ctx, id, updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Updating Alert Responder",
-				"Could not update alert responder: "+err.Error(),
-			)
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error Updating Alert Responder", "Could not update alert responder: ", err)
+			saveAppliedState(updated)
 			return
 		}
+		updated = alertResponder
 
 		// Update URL if returned
 		if alertResponder.URL != "" {
 			plan.URL = types.StringValue(alertResponder.URL)
+			applied.URL = plan.URL
 		}
+		applied.Name = plan.Name
+		applied.WebhookSources = plan.WebhookSources
+		applied.MatchingCriteria = plan.MatchingCriteria
+		applied.Runbook = plan.Runbook
+		applied.NotificationIntegrationIDs = plan.NotificationIntegrationIDs
+		applied.UpdatedAt = types.StringValue(alertResponder.UpdatedAt)
 	}
 
 	// Read back to get full details
 	fullAlertResponder, err := r.client.GetAlertResponder(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Alert Responder",
-			"Could not read alert responder after update: "+err.Error(),
-		)
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Reading Alert Responder", "Could not read alert responder after update: ", err)
+		saveAppliedState(updated)
 		return
 	}
 
+	// Keep the previous URL when the update request was not sent; an
+	// imported alert responder has no URL until its next update
+	if plan.URL.IsUnknown() {
+		plan.URL = state.URL
+	}
+
 	// Update state
 	plan.CreatedAt = types.StringValue(fullAlertResponder.CreatedAt)
 	plan.UpdatedAt = types.StringValue(fullAlertResponder.UpdatedAt)
 	plan.Enabled = types.BoolValue(fullAlertResponder.Status == "ACTIVE")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, fullAlertResponder)...)
+}
+
+// completePendingCreate sends the create request of a pending create again,
+// with the Idempotency-Key kept in private state. When it fails, the pending
+// state and the key are kept for the next apply.
+func (r *alertResponderResource) completePendingCreate(ctx context.Context, req resource.UpdateRequest, plan alertResponderResourceModel, resp *resource.UpdateResponse) {
+	idempotencyKey, diags := getPrivateString(ctx, req.Private, privateKeyCreateIdempotencyKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if idempotencyKey == "" {
+		resp.Diagnostics.AddError(
+			"Error Creating Alert Responder",
+			fmt.Sprintf("Alert responder %q in team %q is pending creation, but the Idempotency-Key of its create request is missing, so the request cannot be sent again safely. "+
+				"Remove the resource from state with `terraform state rm`, then import the alert responder if it exists, or apply again to create it.",
+				plan.Name.ValueString(), plan.TeamName.ValueString()),
+		)
+		return
+	}
+
+	var configRunbook *runbookModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("runbook"), &configRunbook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq := buildCreateRequest(&plan, configRunbook)
+	createReq.IdempotencyKey = idempotencyKey
+
+	alertResponder, err := r.client.CreateAlertResponder(ctx, createReq)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error Creating Alert Responder", "Could not complete the creation of the alert responder: ", err)
+		return
+	}
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, privateKeyCreateIdempotencyKey, "")...)
+
+	fullAlertResponder, err := r.completeCreate(ctx, &plan, createReq, alertResponder, &resp.Diagnostics)
+	if err != nil {
+		// An update does not taint the resource: enabled is saved as it is on
+		// the server, so the next apply disables it
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Disabling Alert Responder", "Alert responder was created but could not be disabled: ", err)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, fullAlertResponder)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -470,27 +1154,291 @@ func (r *alertResponderResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	// Delete the alert responder
-	err := r.client.DeleteAlertResponder(ctx, state.ID.ValueString())
+	// The creation of a pending responder was never confirmed, so there is
+	// nothing known to delete
+	if isPendingCreate(state) {
+		resp.Diagnostics.AddWarning(
+			"Pending Alert Responder Not Deleted",
+			fmt.Sprintf("The creation of alert responder %q in team %q was never confirmed, so it is removed from state without being deleted. If it was created, delete it in TierZero.",
+				state.Name.ValueString(), state.TeamName.ValueString()),
+		)
+		return
+	}
+
+	// State written before deletion_protection was added is protected too
+	if (state.DeletionProtection.IsNull() || state.DeletionProtection.ValueBool()) && !r.ignoreDeletionProtection {
+		resp.Diagnostics.AddError(
+			"Alert Responder Protected From Deletion",
+			fmt.Sprintf("Alert responder %s (%q in team %q) has deletion_protection enabled, so Terraform will not delete or replace it. "+
+				"If this is intended, set deletion_protection = false, apply, and then run the operation again.\n\n"+
+				"To stop managing the alert responder without deleting it, use a `removed` block or `terraform state rm` instead.",
+				state.ID.ValueString(), state.Name.ValueString(), state.TeamName.ValueString()),
+		)
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the alert responder, permanently if purge_on_destroy is set
+	var err error
+	if state.PurgeOnDestroy.ValueBool() {
+		err = r.client.PurgeAlertResponder(ctx, state.ID.ValueString())
+	} else {
+		err = r.client.DeleteAlertResponder(ctx, state.ID.ValueString())
+	}
 	if err != nil {
 		if !client.IsNotFound(err) {
-			resp.Diagnostics.AddError(
-				"Error Deleting Alert Responder",
-				"Could not delete alert responder: "+err.Error(),
-			)
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Deleting Alert Responder", "Could not delete alert responder: ", err)
 			return
 		}
 	}
 }
 
-// ImportState imports the resource into Terraform state.
+// ImportState imports the resource into Terraform state. The import ID is
+// either the alert responder's Global ID or "team_name/name"; import blocks
+// may give an identity instead.
 func (r *alertResponderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Use the ID provided by the user
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" && req.Identity != nil {
+		r.importStateByIdentity(ctx, req, resp)
+		return
+	}
+
+	if isGlobalID(req.ID) || !strings.Contains(req.ID, "/") {
+		// Use the ID provided by the user
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Split at the first "/": alert responder names may contain "/" too
+	teamName, name, _ := strings.Cut(req.ID, "/")
+	if teamName == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the alert responder's Global ID or \"team_name/name\", got %q.", req.ID),
+		)
+		return
+	}
+
+	alertResponder := r.findAlertResponderByName(ctx, teamName, name, &resp.Diagnostics)
+	if alertResponder == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), alertResponder.ID)...)
+	// The list endpoint returns the URL, which Read cannot fetch
+	if alertResponder.URL != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), alertResponder.URL)...)
+	}
+	resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, alertResponder)...)
+}
+
+// findAlertResponderByName looks up the alert responder with exactly the given
+// team and name. Errors, including no match or several matches, are added to
+// diags and nil is returned.
+func (r *alertResponderResource) findAlertResponderByName(ctx context.Context, teamName, name string, diags *diag.Diagnostics) *client.AlertResponder {
+	matches, err := r.listAlertRespondersNamed(ctx, teamName, name)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, diags, nil, "Error Looking Up Alert Responder", "Could not list alert responders: ", err)
+		return nil
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			"Alert Responder Not Found",
+			fmt.Sprintf("No alert responder named %q exists in team %q. Team and alert responder names are case-sensitive.", name, teamName),
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	}
+
+	diags.AddError(
+		"Ambiguous Alert Responder",
+		fmt.Sprintf("%d alert responders are named %q in team %q. Import one of them by its Global ID instead: %s",
+			len(matches), name, teamName, alertResponderIDs(matches)),
+	)
+	return nil
+}
+
+// listAlertRespondersNamed returns the live alert responders of a team with
+// exactly the given name.
+func (r *alertResponderResource) listAlertRespondersNamed(ctx context.Context, teamName, name string) ([]client.AlertResponder, error) {
+	alertResponders, err := r.client.ListAlertResponders(ctx, &client.ListAlertRespondersOptions{
+		TeamName:   teamName,
+		NamePrefix: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []client.AlertResponder
+	for _, alertResponder := range alertResponders {
+		// The name filter is a prefix match
+		if alertResponder.TeamName == teamName && alertResponder.Name == name {
+			matches = append(matches, alertResponder)
+		}
+	}
+	return matches, nil
+}
+
+// listDeletedAlertRespondersNamed returns the deleted alert responders of a
+// team with exactly the given name.
+func (r *alertResponderResource) listDeletedAlertRespondersNamed(ctx context.Context, teamName, name string) ([]client.AlertResponder, error) {
+	alertResponders, err := r.client.ListDeletedAlertResponders(ctx, &client.ListDeletedAlertRespondersOptions{
+		TeamName:   teamName,
+		NamePrefix: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []client.AlertResponder
+	for _, alertResponder := range alertResponders {
+		// The name filter is a prefix match
+		if alertResponder.TeamName == teamName && alertResponder.Name == name {
+			matches = append(matches, alertResponder)
+		}
+	}
+	return matches, nil
+}
+
+// alertResponderIDs lists the IDs of alert responders for diagnostics
+func alertResponderIDs(alertResponders []client.AlertResponder) string {
+	ids := make([]string, len(alertResponders))
+	for i, alertResponder := range alertResponders {
+		ids[i] = alertResponder.ID
+	}
+	return strings.Join(ids, ", ")
+}
+
+// alertResponderExistsDetail explains a create refused because the name is
+// taken, including the create_before_destroy case where the responder
+// holding it is the one being replaced. id may be empty when unknown.
+func alertResponderExistsDetail(teamName, name, id string) string {
+	existing := fmt.Sprintf("An alert responder named %q already exists in team %q", name, teamName)
+	if id != "" {
+		existing += fmt.Sprintf(" (%s)", id)
+	}
+	return existing + ". Alert responder names are unique within a team.\n\n" +
+		"If this create replaces that alert responder with `create_before_destroy`, both would exist at the same time: " +
+		"change `name` together with the attribute forcing the replacement, or remove `create_before_destroy`. " +
+		"Otherwise, set `adopt_existing = true` or import the existing alert responder with `terraform import` to manage it with Terraform, or choose a different name."
+}
+
+// isGlobalID reports whether s looks like an opaque Global ID, i.e. base64
+// encoding "<type>:<id>". Global IDs may contain "/", so they are checked for
+// before treating an import ID as "team_name/name".
+func isGlobalID(s string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return false
+	}
+	kind, id, found := strings.Cut(string(decoded), ":")
+	return found && kind != "" && id != "" && !strings.ContainsFunc(string(decoded), func(r rune) bool {
+		return r < ' ' || r == utf8.RuneError
+	})
 }
 
 // Helper functions to build client types from Terraform models
 
+// buildCreateRequest returns the request creating the planned alert
+// responder. Only the configured runbook prompts are sent: the API sets the
+// others to the default runbook, as planned.
+func buildCreateRequest(plan *alertResponderResourceModel, configRunbook *runbookModel) *client.CreateAlertResponderRequest {
+	createReq := &client.CreateAlertResponderRequest{
+		TeamName:         plan.TeamName.ValueString(),
+		Name:             plan.Name.ValueString(),
+		MatchingCriteria: buildMatchingCriteria(plan.MatchingCriteria),
+		Runbook:          buildRunbook(configRunbook),
+	}
+
+	// Set webhook_sources or slack_channel_id; ValidateConfig ensures exactly one is set
+	if len(plan.WebhookSources) > 0 {
+		createReq.WebhookSources = buildWebhookSources(plan.WebhookSources)
+	}
+	if !plan.SlackChannelID.IsNull() {
+		slackChannelID := plan.SlackChannelID.ValueString()
+		createReq.SlackChannelID = &slackChannelID
+	}
+
+	if len(plan.NotificationIntegrationIDs) > 0 {
+		createReq.NotificationIntegrationIDs = buildStringList(plan.NotificationIntegrationIDs)
+	}
+
+	// A disabled responder is created PAUSED, so it never investigates alerts
+	if !plan.Enabled.ValueBool() {
+		createReq.Status = "PAUSED"
+	}
+	return createReq
+}
+
+// buildUpdateRequest returns the request changing an alert responder from
+// state to plan, or nil when none of the attributes it covers changed.
+// enabled is changed with separate requests, and the attributes that force
+// replacement are never updated. The runbook is sent as configured, so the
+// API resets the prompts left out to the default runbook.
+func buildUpdateRequest(plan, state *alertResponderResourceModel, configRunbook *runbookModel) *client.UpdateAlertResponderRequest {
+	needsUpdate := !plan.Name.Equal(state.Name) ||
+		webhookSourcesChanged(plan.WebhookSources, state.WebhookSources) ||
+		matchingCriteriaChanged(plan.MatchingCriteria, state.MatchingCriteria) ||
+		runbookChanged(plan.Runbook, state.Runbook) ||
+		notificationIDsChanged(plan.NotificationIntegrationIDs, state.NotificationIntegrationIDs)
+	if !needsUpdate {
+		return nil
+	}
+
+	updateReq := &client.UpdateAlertResponderRequest{}
+
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	if webhookSourcesChanged(plan.WebhookSources, state.WebhookSources) {
+		updateReq.WebhookSources = buildWebhookSources(plan.WebhookSources)
+	}
+
+	// Optional settings removed from the configuration must be cleared
+	// explicitly; empty values are omitted from the request and would
+	// leave the previous value in place on the server.
+	if matchingCriteriaChanged(plan.MatchingCriteria, state.MatchingCriteria) {
+		updateReq.MatchingCriteria = buildMatchingCriteria(plan.MatchingCriteria)
+		if updateReq.MatchingCriteria != nil && updateReq.MatchingCriteria.SlackBotAppUserID == nil {
+			updateReq.Clear = append(updateReq.Clear, client.FieldSlackBotAppUserID)
+		}
+	}
+
+	if runbookChanged(plan.Runbook, state.Runbook) {
+		updateReq.Runbook = buildRunbook(configRunbook)
+		if updateReq.Runbook == nil {
+			updateReq.Clear = append(updateReq.Clear, client.FieldRunbook)
+		} else {
+			if updateReq.Runbook.InvestigationPrompt == "" {
+				updateReq.Clear = append(updateReq.Clear, client.FieldRunbookInvestigationPrompt)
+			}
+			if updateReq.Runbook.ImpactAndSeverityPrompt == "" {
+				updateReq.Clear = append(updateReq.Clear, client.FieldRunbookImpactAndSeverity)
+			}
+		}
+	}
+
+	if notificationIDsChanged(plan.NotificationIntegrationIDs, state.NotificationIntegrationIDs) {
+		updateReq.NotificationIntegrationIDs = buildStringList(plan.NotificationIntegrationIDs)
+		if len(updateReq.NotificationIntegrationIDs) == 0 {
+			updateReq.Clear = append(updateReq.Clear, client.FieldNotificationIntegrationIDs)
+		}
+	}
+
+	return updateReq
+}
+
 func buildWebhookSources(sources []webhookSourceModel) []client.WebhookSource {
 	result := make([]client.WebhookSource, len(sources))
 	for i, s := range sources {
@@ -540,7 +1488,44 @@ func buildStringList(list []types.String) []string {
 
 // Helper functions to map client types to Terraform models
 
+// mapAlertResponder updates a model from an alert responder returned by the
+// API. Attributes the API may leave out, such as url, keep their value.
+func mapAlertResponder(alertResponder *client.AlertResponder, state *alertResponderResourceModel) {
+	state.ID = types.StringValue(alertResponder.ID)
+	state.TeamName = types.StringValue(alertResponder.TeamName)
+	state.Name = types.StringValue(alertResponder.Name)
+	state.WebhookSources = mapWebhookSources(alertResponder.WebhookSources)
+
+	// Handle slack_channel_id
+	if alertResponder.SlackChannelID != nil && *alertResponder.SlackChannelID != "" {
+		state.SlackChannelID = types.StringValue(*alertResponder.SlackChannelID)
+	} else {
+		state.SlackChannelID = types.StringNull()
+	}
+
+	state.MatchingCriteria = mapMatchingCriteria(alertResponder.MatchingCriteria)
+	state.Runbook = mapRunbook(alertResponder.Runbook)
+	// Keep an omitted notification_integration_ids null rather than empty
+	// after it has been cleared, so the configuration matches the state
+	if len(alertResponder.NotificationIntegrationIDs) > 0 || state.NotificationIntegrationIDs != nil {
+		state.NotificationIntegrationIDs = mapStringList(alertResponder.NotificationIntegrationIDs)
+	}
+	state.Enabled = types.BoolValue(alertResponder.Status == "ACTIVE")
+	state.CreatedAt = types.StringValue(alertResponder.CreatedAt)
+	state.UpdatedAt = types.StringValue(alertResponder.UpdatedAt)
+
+	// Preserve URL if not returned by API (GET doesn't return it)
+	if alertResponder.URL != "" {
+		state.URL = types.StringValue(alertResponder.URL)
+	}
+}
+
 func mapWebhookSources(sources []client.WebhookSource) []webhookSourceModel {
+	// Slack alert responders have no webhook sources; keep the attribute null
+	// so it matches a configuration that omits it
+	if len(sources) == 0 {
+		return nil
+	}
 	result := make([]webhookSourceModel, len(sources))
 	for i, s := range sources {
 		result[i] = webhookSourceModel{
@@ -576,6 +1561,26 @@ func mapRunbook(rb *client.Runbook) *runbookModel {
 	}
 }
 
+// withDefaultPrompts returns the configured runbook with the prompts that are
+// not set taken from the default runbook, as the API does.
+func withDefaultPrompts(configured *runbookModel, defaults *client.Runbook) *runbookModel {
+	if configured == nil {
+		// The API leaves the runbook out when the default runbook is empty
+		if *defaults == (client.Runbook{}) {
+			return nil
+		}
+		return mapRunbook(defaults)
+	}
+	result := *configured
+	if result.InvestigationPrompt.IsNull() {
+		result.InvestigationPrompt = types.StringValue(defaults.InvestigationPrompt)
+	}
+	if result.ImpactAndSeverityPrompt.IsNull() {
+		result.ImpactAndSeverityPrompt = types.StringValue(defaults.ImpactAndSeverityPrompt)
+	}
+	return &result
+}
+
 func mapStringList(list []string) []types.String {
 	result := make([]types.String, len(list))
 	for i, s := range list {
@@ -586,12 +1591,25 @@ func mapStringList(list []string) []types.String {
 
 // Helper functions to detect changes
 
+// requiresReplaceIfSourceModeChanged replaces the alert responder when it
+// switches between webhook and Slack sources, which the API does not support.
+// Webhook sources of an existing webhook alert responder are updated in place.
+func requiresReplaceIfSourceModeChanged(_ context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+}
+
+// The changed helpers compare sets: the API may return elements in any order
+
 func webhookSourcesChanged(plan, state []webhookSourceModel) bool {
 	if len(plan) != len(state) {
 		return true
 	}
-	for i := range plan {
-		if !plan[i].Type.Equal(state[i].Type) || !plan[i].RemoteID.Equal(state[i].RemoteID) {
+	stateSources := make(map[webhookSourceModel]bool, len(state))
+	for _, source := range state {
+		stateSources[source] = true
+	}
+	for _, source := range plan {
+		if !stateSources[source] {
 			return true
 		}
 	}
@@ -605,14 +1623,9 @@ func matchingCriteriaChanged(plan, state *matchingCriteriaModel) bool {
 	if plan == nil {
 		return false
 	}
-	if len(plan.TextMatches) != len(state.TextMatches) {
+	if stringSetChanged(plan.TextMatches, state.TextMatches) {
 		return true
 	}
-	for i := range plan.TextMatches {
-		if !plan.TextMatches[i].Equal(state.TextMatches[i]) {
-			return true
-		}
-	}
 	if !plan.SlackBotAppUserID.Equal(state.SlackBotAppUserID) {
 		return true
 	}
@@ -630,11 +1643,19 @@ func runbookChanged(plan, state *runbookModel) bool {
 }
 
 func notificationIDsChanged(plan, state []types.String) bool {
+	return stringSetChanged(plan, state)
+}
+
+func stringSetChanged(plan, state []types.String) bool {
 	if len(plan) != len(state) {
 		return true
 	}
-	for i := range plan {
-		if !plan[i].Equal(state[i]) {
+	stateValues := make(map[types.String]bool, len(state))
+	for _, v := range state {
+		stateValues[v] = true
+	}
+	for _, v := range plan {
+		if !stateValues[v] {
 			return true
 		}
 	}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+var _ resource.ResourceWithIdentity = &alertResponderResource{}
+
+// alertResponderIdentityModel maps the resource identity schema data.
+type alertResponderIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	ID           types.String `tfsdk:"id"`
+	TeamName     types.String `tfsdk:"team_name"`
+	Name         types.String `tfsdk:"name"`
+}
+
+// IdentitySchema defines the identity of an alert responder. An import block
+// identifies the alert responder by id, or by team_name and name; organization
+// guards against importing with an API key of another organization.
+func (r *alertResponderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "Name of the organization the alert responder belongs to",
+				OptionalForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "Alert Responder Global ID",
+				OptionalForImport: true,
+			},
+			"team_name": identityschema.StringAttribute{
+				Description:       "Team name",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Alert responder name",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// setAlertResponderIdentity sets the identity from an alert responder returned
+// by the API. The identity changes when the alert responder is renamed, which
+// is why the resource declares a mutable identity.
+func setAlertResponderIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, alertResponder *client.AlertResponder) diag.Diagnostics {
+	if identity == nil {
+		// Terraform versions before 1.12 do not support resource identity
+		return nil
+	}
+
+	model := alertResponderIdentityModel{
+		Organization: types.StringNull(),
+		ID:           types.StringValue(alertResponder.ID),
+		TeamName:     types.StringValue(alertResponder.TeamName),
+		Name:         types.StringValue(alertResponder.Name),
+	}
+	if alertResponder.OrganizationName != "" {
+		model.Organization = types.StringValue(alertResponder.OrganizationName)
+	}
+	return identity.Set(ctx, model)
+}
+
+// importStateByIdentity imports the alert responder identified by the identity
+// attribute of an import block.
+func (r *alertResponderResource) importStateByIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity alertResponderIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var alertResponder *client.AlertResponder
+	switch {
+	case !identity.ID.IsNull():
+		found, err := r.client.GetAlertResponder(ctx, identity.ID.ValueString())
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Alert Responder Not Found",
+					fmt.Sprintf("No alert responder with ID %q exists in this organization.", identity.ID.ValueString()),
+				)
+				return
+			}
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Looking Up Alert Responder", "Could not read alert responder: ", err)
+			return
+		}
+		alertResponder = found
+	case !identity.TeamName.IsNull() && !identity.Name.IsNull():
+		alertResponder = r.findAlertResponderByName(ctx, identity.TeamName.ValueString(), identity.Name.ValueString(), &resp.Diagnostics)
+		if alertResponder == nil {
+			return
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Import Identity",
+			"The import identity must set either id, or both team_name and name.",
+		)
+		return
+	}
+
+	// Every attribute set in the import block must match what was found
+	mismatches := []struct {
+		name      string
+		want, got string
+		set       bool
+	}{
+		{"organization", identity.Organization.ValueString(), alertResponder.OrganizationName, !identity.Organization.IsNull() && alertResponder.OrganizationName != ""},
+		{"id", identity.ID.ValueString(), alertResponder.ID, !identity.ID.IsNull()},
+		{"team_name", identity.TeamName.ValueString(), alertResponder.TeamName, !identity.TeamName.IsNull()},
+		{"name", identity.Name.ValueString(), alertResponder.Name, !identity.Name.IsNull()},
+	}
+	for _, m := range mismatches {
+		if m.set && m.want != m.got {
+			resp.Diagnostics.AddError(
+				"Import Identity Mismatch",
+				fmt.Sprintf("The import identity sets %s to %q, but alert responder %s has %s %q.", m.name, m.want, alertResponder.ID, m.name, m.got),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), alertResponder.ID)...)
+	if alertResponder.URL != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), alertResponder.URL)...)
+	}
+	resp.Diagnostics.Append(setAlertResponderIdentity(ctx, resp.Identity, alertResponder)...)
+}
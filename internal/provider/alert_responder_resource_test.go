@@ -0,0 +1,1872 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+const testAccAlertResponderAddress = "tierzero_alert_responder.test"
+
+// newTestAccAlertResponderServer returns a fake API with a few webhook
+// subscriptions and notification integrations to reference
+func newTestAccAlertResponderServer(t *testing.T) (*tierzerotest.Server, client.NotificationIntegration) {
+	t.Helper()
+
+	server := tierzerotest.NewServer(t)
+	server.AddWebhookSubscription(client.WebhookSubscription{Type: "PAGERDUTY", RemoteID: "PABC123", Name: "Production"})
+	server.AddWebhookSubscription(client.WebhookSubscription{Type: "PAGERDUTY", RemoteID: "PDEF456", Name: "Staging"})
+	server.AddWebhookSubscription(client.WebhookSubscription{Type: "OPSGENIE", RemoteID: "og-123", Name: "Opsgenie"})
+	integration := server.AddNotificationIntegration(client.NotificationIntegration{Name: "#incidents", Kind: "SLACK_ALERT"})
+
+	return server, integration
+}
+
+func TestAccAlertResponderResource_webhook(t *testing.T) {
+	server, integration := newTestAccAlertResponderServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Production Alerts"
+
+  webhook_sources = [{
+    type      = "PAGERDUTY"
+    remote_id = "PABC123"
+  }]
+
+  matching_criteria = {
+    text_matches = ["critical", "fatal"]
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("url"), knownvalue.StringRegexp(regexp.MustCompile(`^https://`))),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("created_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("slack_channel_id"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("runbook"), knownvalue.Null()),
+				},
+			},
+			// Import
+			{
+				ResourceName:      testAccAlertResponderAddress,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API only returns the URL from create, update and list
+				ImportStateVerifyIgnore: []string{"url"},
+			},
+			// Import by team and name, which also finds the URL
+			{
+				ResourceName:      testAccAlertResponderAddress,
+				ImportState:       true,
+				ImportStateId:     "Platform/Production Alerts",
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Production Alerts (renamed)"
+
+  webhook_sources = [{
+    type      = "PAGERDUTY"
+    remote_id = "PABC123"
+  }]
+
+  matching_criteria = {
+    text_matches = ["critical"]
+  }
+
+  runbook = {
+    investigation_prompt       = "Find the root cause"
+    impact_and_severity_prompt = "Count affected users"
+  }
+
+  notification_integration_ids = [%q]
+}
+`, integration.ID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("name"), knownvalue.StringExact("Production Alerts (renamed)")),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("runbook").AtMapKey("investigation_prompt"), knownvalue.StringExact("Find the root cause")),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("notification_integration_ids"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(integration.ID),
+					})),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("url"), knownvalue.NotNull()),
+				},
+			},
+			// Remove optional settings
+			{
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Production Alerts (renamed)"
+
+  webhook_sources = [{
+    type      = "PAGERDUTY"
+    remote_id = "PABC123"
+  }]
+
+  matching_criteria = {
+    text_matches = ["critical"]
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("runbook"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("notification_integration_ids"), knownvalue.Null()),
+				},
+				Check: testAccCheckAlertResponderOnServer(server, func(ar client.AlertResponder) error {
+					if ar.Runbook != nil || len(ar.NotificationIntegrationIDs) > 0 {
+						return fmt.Errorf("expected runbook and notification integrations to be cleared, got %+v", ar)
+					}
+					return nil
+				}),
+			},
+		},
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+}
+
+func TestAccAlertResponderResource_webhookSourcesInPlace(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	config := func(sources string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Growing"
+  %s
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`, sources)
+	}
+	production := `{ type = "PAGERDUTY", remote_id = "PABC123" }`
+	staging := `{ type = "PAGERDUTY", remote_id = "PDEF456" }`
+	opsgenie := `{ type = "OPSGENIE", remote_id = "og-123" }`
+
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+	expectSources := func(remoteIDs ...string) resource.TestCheckFunc {
+		return testAccCheckAlertResponderOnServer(server, func(ar client.AlertResponder) error {
+			var got []string
+			for _, source := range ar.WebhookSources {
+				got = append(got, source.RemoteID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(remoteIDs) {
+				return fmt.Errorf("expected webhook sources %v, got %v", remoteIDs, got)
+			}
+			return nil
+		})
+	}
+	inPlace := resource.ConfigPlanChecks{
+		PreApply: []plancheck.PlanCheck{
+			plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+		},
+		PostApplyPostRefresh: []plancheck.PlanCheck{
+			plancheck.ExpectEmptyPlan(),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("webhook_sources = [" + production + "]"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(testAccAlertResponderAddress, tfjsonpath.New("id")),
+				},
+			},
+			// Adding sources updates the responder in place
+			{
+				Config:           config("webhook_sources = [" + production + ", " + staging + ", " + opsgenie + "]"),
+				ConfigPlanChecks: inPlace,
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(testAccAlertResponderAddress, tfjsonpath.New("id")),
+				},
+				Check: expectSources("PABC123", "PDEF456", "og-123"),
+			},
+			// So does removing them
+			{
+				Config:           config("webhook_sources = [" + staging + "]"),
+				ConfigPlanChecks: inPlace,
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue(testAccAlertResponderAddress, tfjsonpath.New("id")),
+				},
+				Check: expectSources("PDEF456"),
+			},
+			// Switching to a Slack channel still replaces the responder. The
+			// deleted responder keeps holding its name, so a new one is used.
+			{
+				Config: strings.Replace(config(`slack_channel_id = "C07TUN1EFFU"`), "Growing", "Growing (Slack)", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_importByName(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	existing := func(name string) client.AlertResponder {
+		channel := "C07TUN1EFFU"
+		return server.PutAlertResponder(client.AlertResponder{
+			TeamName:         "Platform",
+			Name:             name,
+			SlackChannelID:   &channel,
+			MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+		})
+	}
+	unique := existing("Created in the UI")
+	existing("Created in the UI (copy)")
+	existing("Duplicate")
+	existing("Duplicate")
+
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Created in the UI"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       testAccAlertResponderAddress,
+				ImportState:        true,
+				ImportStateId:      "Platform/Created in the UI",
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].ID != unique.ID {
+						return fmt.Errorf("expected to import %s, got %v", unique.ID, states)
+					}
+					if states[0].Attributes["url"] != unique.URL {
+						return fmt.Errorf("expected url %q, got %q", unique.URL, states[0].Attributes["url"])
+					}
+					return nil
+				},
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config:        config,
+				ResourceName:  testAccAlertResponderAddress,
+				ImportState:   true,
+				ImportStateId: "Platform/Duplicate",
+				ExpectError:   regexp.MustCompile(`Ambiguous Alert Responder`),
+			},
+			{
+				Config:        config,
+				ResourceName:  testAccAlertResponderAddress,
+				ImportState:   true,
+				ImportStateId: "Platform/Missing",
+				ExpectError:   regexp.MustCompile(`No alert responder named "Missing" exists in team "Platform"`),
+			},
+			{
+				Config:        config,
+				ResourceName:  testAccAlertResponderAddress,
+				ImportState:   true,
+				ImportStateId: "Platform/",
+				ExpectError:   regexp.MustCompile(`Invalid Import ID`),
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_importByIdentity(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	channel := "C07TUN1EFFU"
+	existing := server.PutAlertResponder(client.AlertResponder{
+		TeamName:         "Platform",
+		Name:             "Created in the UI",
+		SlackChannelID:   &channel,
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+	})
+
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Created in the UI"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`
+	// importOther imports into a second resource, so that the import block
+	// is not skipped for a resource that is already in state
+	importOther := func(identity string) string {
+		return config + `
+resource "tierzero_alert_responder" "other" {
+  team_name        = "Platform"
+  name             = "Created in the UI"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+
+import {
+  to       = tierzero_alert_responder.other
+  identity = ` + identity + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		// Import blocks by identity require Terraform 1.12
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+import {
+  to = tierzero_alert_responder.test
+  identity = {
+    team_name = "Platform"
+    name      = "Created in the UI"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("id"), knownvalue.StringExact(existing.ID)),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("url"), knownvalue.StringExact(existing.URL)),
+					statecheck.ExpectIdentity(testAccAlertResponderAddress, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(tierzerotest.OrganizationName),
+						"id":           knownvalue.StringExact(existing.ID),
+						"team_name":    knownvalue.StringExact("Platform"),
+						"name":         knownvalue.StringExact("Created in the UI"),
+					}),
+				},
+			},
+			{
+				Config:      importOther(fmt.Sprintf(`{ id = %q, organization = "another-organization" }`, existing.ID)),
+				ExpectError: regexp.MustCompile(`Import Identity Mismatch`),
+			},
+			{
+				Config:      importOther(`{ id = "QWxlcnRSZXNwb25kZXI6OTk5" }`),
+				ExpectError: regexp.MustCompile(`Alert Responder Not Found`),
+			},
+			{
+				Config:      importOther(`{ team_name = "Platform" }`),
+				ExpectError: regexp.MustCompile(`Invalid Import Identity`),
+			},
+			// Import with an import block giving the identity stored in state
+			{
+				Config:          config,
+				ResourceName:    testAccAlertResponderAddress,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// The identity follows a rename
+			{
+				Config: strings.Replace(config, `"Created in the UI"`, `"Renamed in Terraform"`, 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValue(testAccAlertResponderAddress, tfjsonpath.New("name"), knownvalue.StringExact("Renamed in Terraform")),
+					statecheck.ExpectIdentityValueMatchesState(testAccAlertResponderAddress, tfjsonpath.New("id")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_slack(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	config := func(botID string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Slack Alerts"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches          = ["database", "timeout"]
+    slack_bot_app_user_id = %s
+  }
+}
+`, botID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"B01234567"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("slack_channel_id"), knownvalue.StringExact("C07TUN1EFFU")),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("webhook_sources"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("matching_criteria").AtMapKey("slack_bot_app_user_id"), knownvalue.StringExact("B01234567")),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Clearing the bot filter updates in place
+			{
+				Config: config("null"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("matching_criteria").AtMapKey("slack_bot_app_user_id"), knownvalue.Null()),
+				},
+			},
+		},
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+}
+
+func TestAccAlertResponderResource_enabled(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	config := func(enabled bool) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Toggled"
+  slack_channel_id = "C07TUN1EFFU"
+  enabled          = %t
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`, enabled)
+	}
+
+	expectStatus := func(status string) resource.TestCheckFunc {
+		return testAccCheckAlertResponderOnServer(server, func(ar client.AlertResponder) error {
+			if ar.Status != status {
+				return fmt.Errorf("expected status %s, got %s", status, ar.Status)
+			}
+			return nil
+		})
+	}
+
+	// A disabled responder is created PAUSED rather than disabled afterwards,
+	// so it is never briefly ACTIVE
+	expectCreatedPaused := func(*terraform.State) error {
+		for _, request := range server.Requests() {
+			switch {
+			case request.Method == http.MethodPost && request.Path == "/api/v1/alert-responders":
+				if !strings.Contains(string(request.Body), `"status":"PAUSED"`) {
+					return fmt.Errorf("expected the alert responder to be created PAUSED, got body %s", request.Body)
+				}
+			case strings.HasSuffix(request.Path, "/disable"):
+				return fmt.Errorf("expected no disable request, got POST %s", request.Path)
+			}
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check:  resource.ComposeAggregateTestCheckFunc(expectStatus("PAUSED"), expectCreatedPaused),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: config(true),
+				Check:  expectStatus("ACTIVE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: config(false),
+				Check:  expectStatus("PAUSED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_createReadBackFailure(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertRespondersDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				// Fail the read-back after create, including every retry. The
+				// responder was created as planned, so the apply succeeds with
+				// a warning and its state comes from the create response.
+				PreConfig: func() {
+					server.FailNext(http.MethodGet, "/api/v1/alert-responders/*", http.StatusInternalServerError, 4)
+				},
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Half Created"
+  slack_channel_id = "C07TUN1EFFU"
+  enabled          = false
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testAccAlertResponderAddress, "id"),
+					resource.TestCheckResourceAttrSet(testAccAlertResponderAddress, "url"),
+					resource.TestCheckResourceAttrSet(testAccAlertResponderAddress, "created_at"),
+					resource.TestCheckResourceAttr(testAccAlertResponderAddress, "enabled", "false"),
+					func(*terraform.State) error {
+						if remaining := server.AlertResponders(); len(remaining) != 1 {
+							return fmt.Errorf("expected a single alert responder, got %d", len(remaining))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_createOutcomeUnknown(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Lost in Transit"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`
+	// createKeys returns the Idempotency-Key of every create request
+	createKeys := func() []string {
+		var keys []string
+		for _, request := range server.Requests() {
+			if request.Method == http.MethodPost && request.Path == "/api/v1/alert-responders" {
+				keys = append(keys, request.Header.Get("Idempotency-Key"))
+			}
+		}
+		return keys
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertRespondersDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				// The responder is created, but every response is lost, so
+				// the apply cannot tell and saves it as pending
+				PreConfig: func() {
+					server.InjectFault(tierzerotest.Fault{
+						Method:          http.MethodPost,
+						Path:            "/api/v1/alert-responders",
+						StatusCode:      http.StatusBadGateway,
+						AfterProcessing: true,
+						Times:           4,
+					})
+				},
+				Config:             config,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("id"), knownvalue.StringExact("")),
+				},
+			},
+			// The next apply sends the request again with the same key, which
+			// returns the responder created by the first one
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: func(state *terraform.State) error {
+					remaining := server.AlertResponders()
+					if len(remaining) != 1 {
+						return fmt.Errorf("expected a single alert responder, got %d", len(remaining))
+					}
+					if id := state.RootModule().Resources[testAccAlertResponderAddress].Primary.ID; id != remaining[0].ID {
+						return fmt.Errorf("expected ID %s in state, got %q", remaining[0].ID, id)
+					}
+					keys := createKeys()
+					if len(keys) != 5 || keys[0] == "" || len(slices.Compact(slices.Clone(keys))) != 1 {
+						return fmt.Errorf("expected 5 create requests sharing one Idempotency-Key, got %q", keys)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_createDisableFailure(t *testing.T) {
+	// The API creates the responder ACTIVE, so it must be disabled afterwards
+	server := tierzerotest.NewServer(t, tierzerotest.WithoutCreateStatus())
+
+	config := func(name string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = %q
+  slack_channel_id = "C07TUN1EFFU"
+  enabled          = false
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Also proves the responder created by the failed apply was tracked:
+		// an orphaned one would never be deleted
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.FailNext(http.MethodPost, "/api/v1/alert-responders/*", http.StatusInternalServerError, 4)
+				},
+				Config:      config("Half Created"),
+				ExpectError: regexp.MustCompile(`terraform untaint`),
+			},
+			// The created responder is in state as tainted, so it is replaced.
+			// Deleted responders keep holding their name, hence the rename,
+			// and the provider configuration turns deletion protection off.
+			{
+				Config: config("Recreated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccAlertResponderAddress, "name", "Recreated"),
+					resource.TestCheckResourceAttr(testAccAlertResponderAddress, "enabled", "false"),
+					func(*terraform.State) error {
+						if remaining := server.AlertResponders(); len(remaining) != 1 {
+							return fmt.Errorf("expected only the replacement alert responder to remain, got %d", len(remaining))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_requiresReplace(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	// Deleted alert responders keep holding their name, so every replacement
+	// uses a new one
+	config := func(teamName, name, source string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name = %q
+  name      = %q
+  %s
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`, teamName, name, source)
+	}
+	webhook := `webhook_sources = [{ type = "PAGERDUTY", remote_id = "PABC123" }]`
+	slack := `slack_channel_id = "C07TUN1EFFU"`
+
+	var firstID, secondID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Platform", "Replaced", webhook),
+				Check:  testAccCaptureAlertResponderID(&firstID),
+			},
+			// Changing team_name forces replacement
+			{
+				Config: config("Search", "Replaced", webhook),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCaptureAlertResponderID(&secondID),
+					func(*terraform.State) error {
+						if firstID == secondID {
+							return fmt.Errorf("expected a new alert responder, got the same ID %s", firstID)
+						}
+						if !server.IsDeleted(firstID) {
+							return fmt.Errorf("expected the replaced alert responder %s to be deleted", firstID)
+						}
+						return nil
+					},
+				),
+			},
+			// Switching from webhook to Slack sources forces replacement
+			{
+				Config: config("Search", "Replaced (Slack)", slack),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+			// Changing the Slack channel forces replacement
+			{
+				Config: config("Search", "Replaced (private)", `slack_channel_id = "G0PRIVATE1"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+}
+
+func TestAccAlertResponderResource_createBeforeDestroy(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	config := func(name, channel string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = %q
+  slack_channel_id = %q
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, name, channel)
+	}
+
+	var firstID, secondID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Live", "C07TUN1EFFU"),
+				Check:  testAccCaptureAlertResponderID(&firstID),
+			},
+			// The replacement would be created while the responder it replaces
+			// still holds the name, so it is refused before anything changes
+			{
+				Config:      config("Live", "G0PRIVATE1"),
+				ExpectError: regexp.MustCompile(`Alert Responder Replacement Needs a New Name`),
+			},
+			{
+				Config: config("Live", "C07TUN1EFFU"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(testAccAlertResponderAddress, "id", &firstID),
+					func(*terraform.State) error {
+						if server.IsDeleted(firstID) {
+							return fmt.Errorf("expected the refused replacement to keep alert responder %s", firstID)
+						}
+						return nil
+					},
+				),
+			},
+			// Renaming along with the replacement lets both exist at once
+			{
+				Config: config("Live (private)", "G0PRIVATE1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCaptureAlertResponderID(&secondID),
+					func(*terraform.State) error {
+						if firstID == secondID {
+							return fmt.Errorf("expected a new alert responder, got the same ID %s", firstID)
+						}
+						if !server.IsDeleted(firstID) {
+							return fmt.Errorf("expected the replaced alert responder %s to be deleted", firstID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+}
+
+func TestAccAlertResponderResource_adoptExisting(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	handMade, err := server.Client().CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{
+		TeamName:         "Platform",
+		Name:             "Hand Made",
+		WebhookSources:   []client.WebhookSource{{Type: "PAGERDUTY", RemoteID: "PABC123"}},
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"old"}},
+		Runbook:          &client.Runbook{InvestigationPrompt: "Set by hand"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create alert responder: %s", err)
+	}
+	other, err := server.Client().CreateAlertResponder(context.Background(), &client.CreateAlertResponderRequest{
+		TeamName:         "Platform",
+		Name:             "Other",
+		WebhookSources:   []client.WebhookSource{{Type: "PAGERDUTY", RemoteID: "PABC123"}},
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"other"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create alert responder: %s", err)
+	}
+
+	config := func(name, source, adoptExisting string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = %q
+  %s
+  enabled   = false
+  %s
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`, name, source, adoptExisting)
+	}
+	webhook := `webhook_sources = [{ type = "PAGERDUTY", remote_id = "PABC123" }]`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Taken names are reported at plan time
+			{
+				Config:      config("Hand Made", webhook, ""),
+				ExpectError: regexp.MustCompile(`(?s)Alert Responder Already Exists.*adopt_existing`),
+			},
+			// Attributes forcing replacement must already match
+			{
+				Config:      config("Hand Made", `slack_channel_id = "C07TUN1EFFU"`, "adopt_existing = true"),
+				ExpectError: regexp.MustCompile(`Alert Responder Cannot Be Adopted`),
+			},
+			{
+				Config: config("Hand Made", webhook, "adopt_existing = true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccAlertResponderAddress, "id", handMade.ID),
+					resource.TestCheckResourceAttr(testAccAlertResponderAddress, "url", handMade.URL),
+					testAccCheckAlertResponderOnServer(server, func(ar client.AlertResponder) error {
+						if ar.Status != "PAUSED" {
+							return fmt.Errorf("expected the adopted alert responder to be disabled, got status %s", ar.Status)
+						}
+						if !slices.Equal(ar.MatchingCriteria.TextMatches, []string{"error"}) {
+							return fmt.Errorf("expected the adopted alert responder to be updated, got text matches %v", ar.MatchingCriteria.TextMatches)
+						}
+						if ar.Runbook != nil && ar.Runbook.InvestigationPrompt == "Set by hand" {
+							return errors.New("expected the runbook missing from the configuration to be cleared")
+						}
+						return nil
+					}),
+				),
+			},
+			// Renaming to a taken name is reported at plan time too
+			{
+				Config:      config(other.Name, webhook, "adopt_existing = true"),
+				ExpectError: regexp.MustCompile(`Alert Responder Name Already Taken`),
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if !server.IsDeleted(handMade.ID) {
+				return fmt.Errorf("expected the adopted alert responder %s to be deleted", handMade.ID)
+			}
+			if server.IsDeleted(other.ID) {
+				return fmt.Errorf("expected alert responder %s to be left alone", other.ID)
+			}
+			return nil
+		},
+	})
+
+	// Only the two alert responders above were created
+	creates := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost && request.Path == "/api/v1/alert-responders" {
+			creates++
+		}
+	}
+	if creates != 2 {
+		t.Errorf("expected the existing alert responder to be adopted without a create request, got %d create requests", creates)
+	}
+}
+
+func TestAccAlertResponderResource_restoreIfDeleted(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	deleted := server.PutAlertResponder(client.AlertResponder{
+		TeamName:         "Platform",
+		Name:             "Deleted",
+		WebhookSources:   []client.WebhookSource{{Type: "PAGERDUTY", RemoteID: "PABC123"}},
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"old"}},
+		Status:           "PAUSED",
+	})
+	server.DeleteAlertResponder(deleted.ID)
+
+	config := func(source string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name          = "Platform"
+  name               = "Deleted"
+  %s
+  restore_if_deleted = true
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`, source)
+	}
+	webhook := `webhook_sources = [{ type = "PAGERDUTY", remote_id = "PABC123" }]`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Attributes forcing replacement must already match
+			{
+				Config:      config(`slack_channel_id = "C07TUN1EFFU"`),
+				ExpectError: regexp.MustCompile(`Alert Responder Cannot Be Restored`),
+			},
+			{
+				Config: config(webhook),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccAlertResponderAddress, "id", deleted.ID),
+					testAccCheckAlertResponderOnServer(server, func(ar client.AlertResponder) error {
+						if ar.Status != "ACTIVE" {
+							return fmt.Errorf("expected the restored alert responder to be enabled, got status %s", ar.Status)
+						}
+						if !slices.Equal(ar.MatchingCriteria.TextMatches, []string{"error"}) {
+							return fmt.Errorf("expected the restored alert responder to be updated, got text matches %v", ar.MatchingCriteria.TextMatches)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost && request.Path == "/api/v1/alert-responders" {
+			t.Errorf("expected the deleted alert responder to be restored without a create request")
+		}
+	}
+}
+
+func TestAccAlertResponderResource_restoreUpdateFailure(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	deleted := server.PutAlertResponder(client.AlertResponder{
+		TeamName:         "Platform",
+		Name:             "Deleted",
+		WebhookSources:   []client.WebhookSource{{Type: "PAGERDUTY", RemoteID: "PABC123"}},
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"old"}},
+		Status:           "PAUSED",
+	})
+	server.DeleteAlertResponder(deleted.ID)
+
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name          = "Platform"
+  name               = "Deleted"
+  webhook_sources    = [{ type = "PAGERDUTY", remote_id = "PABC123" }]
+  restore_if_deleted = true
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A responder restored but not updated is kept in state, with the
+			// steps that succeeded, instead of being deleted again
+			{
+				PreConfig: func() {
+					server.FailNext(http.MethodPut, "/api/v1/alert-responders/*", http.StatusInternalServerError, 4)
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s)was restored but it could\s+not be updated.*terraform untaint`),
+			},
+			// The tainted responder is in state: replacing it is refused since
+			// it holds the name, which is why the error suggests untainting it
+			{
+				PreConfig: func() {
+					ar, ok := server.AlertResponder(deleted.ID)
+					if !ok || server.IsDeleted(deleted.ID) {
+						t.Fatalf("expected alert responder %s to stay restored after the failed update", deleted.ID)
+					}
+					if ar.Status != "ACTIVE" {
+						t.Fatalf("expected the restored alert responder to be enabled, got status %s", ar.Status)
+					}
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s)Alert Responder Already Exists.*because it is\s+tainted`),
+			},
+		},
+		// Only a responder saved to state is deleted by the destroy
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+}
+
+func TestAccAlertResponderResource_purgeOnDestroy(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Purged"
+  slack_channel_id = "C07TUN1EFFU"
+  purge_on_destroy = true
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureAlertResponderID(&id),
+			},
+			{
+				Config: server.ProviderConfig(),
+				Check: func(*terraform.State) error {
+					if _, ok := server.AlertResponder(id); ok || server.IsDeleted(id) {
+						return fmt.Errorf("expected alert responder %s to be purged", id)
+					}
+					return nil
+				},
+			},
+			// The purged responder no longer holds its name
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					if s.RootModule().Resources[testAccAlertResponderAddress].Primary.ID == id {
+						return fmt.Errorf("expected a new alert responder, got the purged %s", id)
+					}
+					return testAccCaptureAlertResponderID(&id)(s)
+				},
+			},
+			// A replacement can keep the name, since destroying the responder
+			// being replaced frees it
+			{
+				Config: strings.Replace(config, "C07TUN1EFFU", "C0NEWCHANL", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					if s.RootModule().Resources[testAccAlertResponderAddress].Primary.ID == id {
+						return fmt.Errorf("expected alert responder %s to be replaced", id)
+					}
+					if _, ok := server.AlertResponder(id); ok || server.IsDeleted(id) {
+						return fmt.Errorf("expected the replaced alert responder %s to be purged", id)
+					}
+					return nil
+				},
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if remaining := server.AlertResponders(); len(remaining) > 0 {
+				return fmt.Errorf("expected every alert responder to be purged, %d remain", len(remaining))
+			}
+			deleted, err := server.Client().ListDeletedAlertResponders(context.Background(), nil)
+			if err != nil {
+				return err
+			}
+			if len(deleted) > 0 {
+				return fmt.Errorf("expected every alert responder to be purged, %d are soft deleted", len(deleted))
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccAlertResponderResource_deletionProtection(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	protectedProvider := strings.Replace(server.ProviderConfig(), "deletion_protection = false", "", 1)
+
+	config := func(provider, deletionProtection string) string {
+		return provider + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Protected"
+  slack_channel_id = "C07TUN1EFFU"
+  %s
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`, deletionProtection)
+	}
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(protectedProvider, ""),
+				Check:  testAccCaptureAlertResponderID(&id),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("deletion_protection"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config:      config(protectedProvider, ""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Alert Responder Protected From Deletion`),
+			},
+			// Replacing is deleting too
+			{
+				PreConfig: func() {
+					if server.IsDeleted(id) {
+						t.Fatalf("expected alert responder %s to survive the refused destroy", id)
+					}
+				},
+				Config: strings.NewReplacer(`"Protected"`, `"Protected (private)"`, "C07TUN1EFFU", "G0PRIVATE1").Replace(config(protectedProvider, "")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ExpectError: regexp.MustCompile(`Alert Responder Protected From Deletion`),
+			},
+			// Turning the protection off only changes the state
+			{
+				Config: config(protectedProvider, "deletion_protection = false"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPtr(testAccAlertResponderAddress, "id", &id),
+			},
+			// The provider configuration overrides the resource's protection
+			{
+				Config: config(server.ProviderConfig(), "deletion_protection = true"),
+			},
+		},
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPut {
+			t.Errorf("expected deletion_protection to never update the alert responder, got PUT %s", request.Path)
+		}
+	}
+}
+
+func TestAccAlertResponderResource_disappears(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Disappearing"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureAlertResponderID(&id),
+			},
+			// The deleted responder is planned for creation, but its name is
+			// still held by the soft-deleted copy, which is reported at plan time
+			{
+				PreConfig: func() {
+					if !server.DeleteAlertResponder(id) {
+						t.Fatalf("alert responder %s not found", id)
+					}
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s)Alert Responder Name Held by a Deleted Responder.*restore_if_deleted`),
+			},
+			{
+				Config: strings.Replace(config, `name             = "Disappearing"`, `name             = "Disappearing"
+  restore_if_deleted = true`, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPtr(testAccAlertResponderAddress, "id", &id),
+			},
+		},
+		CheckDestroy: testAccCheckAlertRespondersDestroyed(server),
+	})
+}
+
+func TestAccAlertResponderResource_drift(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Drifting"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureAlertResponderID(&id),
+			},
+			// Changes made in the UI are reverted in place
+			{
+				PreConfig: func() {
+					server.ModifyAlertResponder(id, func(ar *client.AlertResponder) {
+						ar.Name = "Renamed in the UI"
+						ar.MatchingCriteria.TextMatches = []string{"warning"}
+					})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: testAccCheckAlertResponderOnServer(server, func(ar client.AlertResponder) error {
+					if ar.Name != "Drifting" || ar.MatchingCriteria.TextMatches[0] != "error" {
+						return fmt.Errorf("expected drift to be reverted, got %+v", ar)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_defaultRunbook(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	server.SetDefaultRunbook(client.Runbook{
+		InvestigationPrompt:     "Default investigation",
+		ImpactAndSeverityPrompt: "Default impact",
+	})
+
+	config := func(runbook string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Defaulted"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+  %s
+}
+`, runbook)
+	}
+	expectRunbook := func(investigation, impact string) []statecheck.StateCheck {
+		return []statecheck.StateCheck{
+			statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("runbook"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+				"investigation_prompt":       knownvalue.StringExact(investigation),
+				"impact_and_severity_prompt": knownvalue.StringExact(impact),
+			})),
+		}
+	}
+	inPlace := resource.ConfigPlanChecks{
+		PreApply: []plancheck.PlanCheck{
+			plancheck.ExpectResourceAction(testAccAlertResponderAddress, plancheck.ResourceActionUpdate),
+		},
+		PostApplyPostRefresh: []plancheck.PlanCheck{
+			plancheck.ExpectEmptyPlan(),
+		},
+	}
+	// lastBody returns the body of the last request with the given method
+	lastBody := func(method string) string {
+		var body string
+		for _, request := range server.Requests() {
+			if request.Method == method && strings.HasPrefix(request.Path, "/api/v1/alert-responders") {
+				body = string(request.Body)
+			}
+		}
+		return body
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The default runbook is planned and saved, and the next plan is empty
+			{
+				Config: config(""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("runbook").AtMapKey("investigation_prompt"), knownvalue.StringExact("Default investigation")),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: expectRunbook("Default investigation", "Default impact"),
+				Check: func(*terraform.State) error {
+					if body := lastBody(http.MethodPost); strings.Contains(body, `"runbook"`) {
+						return fmt.Errorf("expected the runbook to be left to the server, got body %s", body)
+					}
+					return nil
+				},
+			},
+			// A configured prompt replaces its default only
+			{
+				Config:            config(`runbook = { investigation_prompt = "Custom investigation" }`),
+				ConfigPlanChecks:  inPlace,
+				ConfigStateChecks: expectRunbook("Custom investigation", "Default impact"),
+				Check: testAccCheckAlertResponderOnServer(server, func(ar client.AlertResponder) error {
+					if ar.Runbook == nil || ar.Runbook.InvestigationPrompt != "Custom investigation" || ar.Runbook.ImpactAndSeverityPrompt != "Default impact" {
+						return fmt.Errorf("unexpected runbook %+v", ar.Runbook)
+					}
+					return nil
+				}),
+			},
+			// Removing the runbook resets it to the default
+			{
+				Config:            config(""),
+				ConfigPlanChecks:  inPlace,
+				ConfigStateChecks: expectRunbook("Default investigation", "Default impact"),
+				Check: func(*terraform.State) error {
+					if body := lastBody(http.MethodPut); !strings.Contains(body, `"runbook":null`) {
+						return fmt.Errorf("expected the runbook to be cleared, got body %s", body)
+					}
+					return nil
+				},
+			},
+			// A responder left to the default follows it when it changes
+			{
+				PreConfig: func() {
+					server.SetDefaultRunbook(client.Runbook{InvestigationPrompt: "New investigation"})
+				},
+				Config:            config(""),
+				ConfigPlanChecks:  inPlace,
+				ConfigStateChecks: expectRunbook("New investigation", ""),
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_order(t *testing.T) {
+	server, integration := newTestAccAlertResponderServer(t)
+	other := server.AddNotificationIntegration(client.NotificationIntegration{Name: "Discord", Kind: "DISCORD_WEBHOOK"})
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Ordered"
+
+  webhook_sources = [
+    { type = "PAGERDUTY", remote_id = "PABC123" },
+    { type = "OPSGENIE", remote_id = "og-123" },
+  ]
+
+  matching_criteria = {
+    text_matches = ["critical", "fatal", "panic"]
+  }
+
+  notification_integration_ids = [%q, %q]
+}
+`, integration.ID, other.ID),
+				Check: testAccCaptureAlertResponderID(&id),
+			},
+			// The API returning the same elements in another order is not drift
+			{
+				PreConfig: func() {
+					server.ModifyAlertResponder(id, func(ar *client.AlertResponder) {
+						slices.Reverse(ar.WebhookSources)
+						slices.Reverse(ar.MatchingCriteria.TextMatches)
+						slices.Reverse(ar.NotificationIntegrationIDs)
+					})
+				},
+				RefreshState: true,
+				RefreshPlanChecks: resource.RefreshPlanChecks{
+					PostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Ordered"
+
+  webhook_sources = [
+    { type = "OPSGENIE", remote_id = "og-123" },
+    { type = "PAGERDUTY", remote_id = "PABC123" },
+  ]
+
+  matching_criteria = {
+    text_matches = ["panic", "critical", "fatal"]
+  }
+
+  notification_integration_ids = [%q, %q]
+}
+`, other.ID, integration.ID),
+				PlanOnly: true,
+			},
+		},
+	})
+
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPut {
+			t.Errorf("expected reordering to never update the alert responder, got PUT %s", request.Path)
+		}
+	}
+}
+
+func TestAccAlertResponderResource_timeouts(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+	config := server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Slow Alerts"
+
+  webhook_sources = [{
+    type      = "PAGERDUTY"
+    remote_id = "PABC123"
+  }]
+
+  matching_criteria = {
+    text_matches = ["critical"]
+  }
+
+  timeouts {
+    create = "1s"
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertRespondersDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				// A create that times out may still complete on the server, so
+				// it is saved as pending and completed by the next apply
+				PreConfig: func() {
+					server.DelayNext(http.MethodPost, "/api/v1/alert-responders", 5*time.Second, 1)
+				},
+				Config:             config,
+				ExpectNonEmptyPlan: true,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("id"), knownvalue.StringExact("")),
+				},
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("timeouts").AtMapKey("create"), knownvalue.StringExact("1s")),
+					statecheck.ExpectKnownValue(testAccAlertResponderAddress, tfjsonpath.New("timeouts").AtMapKey("delete"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAlertResponderResource_validation(t *testing.T) {
+	server, _ := newTestAccAlertResponderServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "No Sources"
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Must specify either webhook_sources or slack_channel_id`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Both Sources"
+  slack_channel_id = "C07TUN1EFFU"
+
+  webhook_sources = [{
+    type      = "PAGERDUTY"
+    remote_id = "PABC123"
+  }]
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)with tierzero_alert_responder.test.*slack_channel_id.*Cannot specify both webhook_sources and slack_channel_id`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name       = "Platform"
+  name            = "Empty Sources"
+  webhook_sources = []
+
+  matching_criteria = {
+    text_matches = ["error"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute webhook_sources set must contain at least 1 elements`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name = "Platform"
+  name      = "Webhook Bot Filter"
+
+  webhook_sources = [{
+    type      = "PAGERDUTY"
+    remote_id = "PABC123"
+  }]
+
+  matching_criteria = {
+    text_matches          = ["error"]
+    slack_bot_app_user_id = "B01234567"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`slack_bot_app_user_id filters Slack messages and can only be set together\s+with slack_channel_id`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+resource "tierzero_alert_responder" "test" {
+  team_name        = "Platform"
+  name             = "Empty Match"
+  slack_channel_id = "C07TUN1EFFU"
+
+  matching_criteria = {
+    text_matches = ["error", " "]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`text match must not be empty`),
+			},
+		},
+	})
+
+	// Only the last configuration is valid at plan time
+	creates := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost && request.Path == "/api/v1/alert-responders" {
+			creates++
+		}
+	}
+	if creates != 1 {
+		t.Errorf("expected invalid configurations to fail before any create request, got %d create requests", creates)
+	}
+}
+
+// testAccCaptureAlertResponderID stores the ID of the test alert responder
+func testAccCaptureAlertResponderID(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[testAccAlertResponderAddress]
+		if !ok {
+			return fmt.Errorf("%s not found in state", testAccAlertResponderAddress)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckAlertResponderOnServer runs check against the server-side copy
+// of the test alert responder
+func testAccCheckAlertResponderOnServer(server *tierzerotest.Server, check func(client.AlertResponder) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var id string
+		if err := testAccCaptureAlertResponderID(&id)(s); err != nil {
+			return err
+		}
+		ar, ok := server.AlertResponder(id)
+		if !ok {
+			return fmt.Errorf("alert responder %s not found on the server", id)
+		}
+		return check(ar)
+	}
+}
+
+// testAccCheckAlertRespondersDestroyed verifies that every alert responder
+// created by the test was deleted
+func testAccCheckAlertRespondersDestroyed(server *tierzerotest.Server) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if remaining := server.AlertResponders(); len(remaining) > 0 {
+			return fmt.Errorf("expected every alert responder to be deleted, %d remain", len(remaining))
+		}
+		return nil
+	}
+}
+
+func TestIsGlobalID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{id: "R3JhcGhRTEFsZXJ0OjEyMw==", want: true},
+		// "AlertResponder:???" encodes to a Global ID containing "/"
+		{id: "QWxlcnRSZXNwb25kZXI6Pz8/", want: true},
+		{id: "Platform/Production Alerts", want: false},
+		{id: "Platform/Alerts", want: false},
+		{id: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := isGlobalID(tt.id); got != tt.want {
+			t.Errorf("isGlobalID(%q) = %t, want %t", tt.id, got, tt.want)
+		}
+	}
+}
+
+// A failure after some update steps succeeded saves the changes already made,
+// so they are neither lost with -refresh=false nor planned again
+func TestAlertResponderUpdateSavesAppliedStepsOnFailure(t *testing.T) {
+	ctx := context.Background()
+	server := tierzerotest.NewServer(t)
+	channel := "C07TUN1EFFU"
+	created, err := server.Client().CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+		TeamName:         "Platform",
+		Name:             "Before",
+		SlackChannelID:   &channel,
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create alert responder: %s", err)
+	}
+
+	r := &alertResponderResource{client: server.Client()}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	empty := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	prior := alertResponderResourceModel{
+		Timeouts: timeouts.Value{Object: types.ObjectNull(alertResponderTimeoutsAttrTypes)},
+	}
+	mapAlertResponder(created, &prior)
+	planned := prior
+	planned.Name = types.StringValue("After")
+	planned.Enabled = types.BoolValue(false)
+	planned.UpdatedAt = types.StringUnknown()
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: empty}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: empty}
+	if diags := state.Set(ctx, prior); diags.HasError() {
+		t.Fatalf("failed to build prior state: %v", diags)
+	}
+	if diags := plan.Set(ctx, planned); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	// The disable and update requests succeed, the read-back fails
+	server.FailNext(http.MethodGet, "/api/v1/alert-responders/"+created.ID, http.StatusInternalServerError, 0)
+	resp := fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the update to fail")
+	}
+
+	var saved alertResponderResourceModel
+	if diags := resp.State.Get(ctx, &saved); diags.HasError() {
+		t.Fatalf("failed to read saved state: %v", diags)
+	}
+	if got := saved.Name.ValueString(); got != "After" {
+		t.Errorf("expected the rename to be saved, got name %q", got)
+	}
+	if saved.Enabled.ValueBool() {
+		t.Error("expected the disable to be saved, got enabled = true")
+	}
+	if saved.UpdatedAt.IsUnknown() || saved.UpdatedAt.ValueString() == "" {
+		t.Errorf("expected updated_at from the update response, got %s", saved.UpdatedAt)
+	}
+}
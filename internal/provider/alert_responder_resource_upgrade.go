@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.ResourceWithUpgradeState = &alertResponderResource{}
+
+// alertResponderSchemaVersion is the current version of the alert responder
+// schema. Bump it, and append a migration to alertResponderStateMigrations,
+// for every change that existing state cannot be read with, such as a renamed
+// attribute or a changed type.
+//
+// Version history:
+//
+//   - 0: every release up to 0.0.6. Releases before 0.0.6 name the runbook
+//     prompts prompt and fast_prompt; text_matches,
+//     notification_integration_ids and webhook_sources are lists.
+//   - 1: the runbook prompts are investigation_prompt and
+//     impact_and_severity_prompt; the lists are sets.
+const alertResponderSchemaVersion = 1
+
+// alertResponderStateMigrations upgrades raw state one version at a time:
+// entry i rewrites state of version i into version i+1. State of any prior
+// version is upgraded by running every migration from its version onwards,
+// so each migration only needs to know about the version before it.
+// Attributes missing after the last migration are read as null, and
+// attributes no longer in the schema are dropped.
+var alertResponderStateMigrations = []func(state map[string]any){
+	migrateAlertResponderStateV0,
+}
+
+// UpgradeState upgrades state written by earlier versions of the provider to
+// the current schema.
+func (r *alertResponderResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, alertResponderSchemaVersion)
+	for version := range int64(alertResponderSchemaVersion) {
+		upgraders[version] = resource.StateUpgrader{
+			StateUpgrader: upgradeAlertResponderStateFrom(version),
+		}
+	}
+	return upgraders
+}
+
+// upgradeAlertResponderStateFrom returns an upgrader from the given version
+// to the current one
+func upgradeAlertResponderStateFrom(version int64) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+		if req.RawState == nil || req.RawState.JSON == nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Alert Responder State",
+				fmt.Sprintf("The saved state of version %d is not in JSON format. Remove the resource from state and import it again.", version),
+			)
+			return
+		}
+
+		var state map[string]any
+		if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Alert Responder State",
+				fmt.Sprintf("Could not decode the saved state of version %d: %s", version, err),
+			)
+			return
+		}
+
+		for _, migrate := range alertResponderStateMigrations[version:] {
+			migrate(state)
+		}
+
+		upgraded, err := json.Marshal(state)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Alert Responder State", "Could not encode the upgraded state: "+err.Error())
+			return
+		}
+		raw := tfprotov6.RawState{JSON: upgraded}
+		value, err := raw.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+			ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Alert Responder State",
+				fmt.Sprintf("The state upgraded from version %d does not match the current schema. Please report this issue to the provider developers.\n\n%s", version, err),
+			)
+			return
+		}
+		resp.State.Raw = value
+	}
+}
+
+// migrateAlertResponderStateV0 upgrades version 0 to version 1: it renames
+// the runbook prompts from releases before 0.0.6 and removes duplicates from
+// the attributes that became sets.
+func migrateAlertResponderStateV0(state map[string]any) {
+	if runbook, ok := state["runbook"].(map[string]any); ok {
+		renameAttribute(runbook, "prompt", "investigation_prompt")
+		renameAttribute(runbook, "fast_prompt", "impact_and_severity_prompt")
+	}
+
+	uniqueAttribute(state, "webhook_sources")
+	uniqueAttribute(state, "notification_integration_ids")
+	if matchingCriteria, ok := state["matching_criteria"].(map[string]any); ok {
+		uniqueAttribute(matchingCriteria, "text_matches")
+	}
+}
+
+// renameAttribute moves the value of an attribute to a new name, unless a
+// value is already set under the new name
+func renameAttribute(object map[string]any, from, to string) {
+	value, ok := object[from]
+	if !ok {
+		return
+	}
+	delete(object, from)
+	if object[to] == nil {
+		object[to] = value
+	}
+}
+
+// uniqueAttribute removes repeated elements from a list attribute, keeping
+// the first occurrence, so that it can be read as a set
+func uniqueAttribute(object map[string]any, name string) {
+	elements, ok := object[name].([]any)
+	if !ok {
+		return
+	}
+	seen := make(map[string]bool, len(elements))
+	unique := make([]any, 0, len(elements))
+	for _, element := range elements {
+		key, _ := json.Marshal(element)
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			unique = append(unique, element)
+		}
+	}
+	object[name] = unique
+}
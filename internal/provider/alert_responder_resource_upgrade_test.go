@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeAlertResponderState runs raw state of the given version through the
+// provider's UpgradeResourceState RPC, as Terraform does on refresh
+func upgradeAlertResponderState(t *testing.T, version int64, rawState string) alertResponderResourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("failed to start provider server: %s", err)
+	}
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "tierzero_alert_responder",
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatalf("UpgradeResourceState failed: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	var schemaResp resource.SchemaResponse
+	NewAlertResponderResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	raw, err := resp.UpgradedState.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("failed to decode upgraded state: %s", err)
+	}
+
+	var model alertResponderResourceModel
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("failed to read upgraded state: %v", diags)
+	}
+	return model
+}
+
+func TestAlertResponderUpgradeStateFromV006(t *testing.T) {
+	model := upgradeAlertResponderState(t, 0, `{
+  "id": "R3JhcGhRTEFsZXJ0UmVzcG9uZGVyOjE=",
+  "team_name": "Platform",
+  "name": "Production Alerts",
+  "webhook_sources": [
+    {"type": "PAGERDUTY", "remote_id": "PABC123"},
+    {"type": "OPSGENIE", "remote_id": "og-123"}
+  ],
+  "slack_channel_id": null,
+  "matching_criteria": {"text_matches": ["critical", "fatal", "critical"], "slack_bot_app_user_id": null},
+  "runbook": {"investigation_prompt": "Investigate", "impact_and_severity_prompt": "Assess"},
+  "notification_integration_ids": ["TmkxMjM="],
+  "enabled": true,
+  "url": "https://app.tierzero.ai/alert-responders/R3JhcGhRTEFsZXJ0UmVzcG9uZGVyOjE=",
+  "created_at": "2025-10-28T10:00:00Z",
+  "updated_at": "2025-10-28T10:00:00Z"
+}`)
+
+	if got := model.ID.ValueString(); got != "R3JhcGhRTEFsZXJ0UmVzcG9uZGVyOjE=" {
+		t.Errorf("unexpected id %q", got)
+	}
+	if len(model.WebhookSources) != 2 {
+		t.Errorf("expected 2 webhook sources, got %d", len(model.WebhookSources))
+	}
+	if len(model.MatchingCriteria.TextMatches) != 2 {
+		t.Errorf("expected 2 text matches, got %d", len(model.MatchingCriteria.TextMatches))
+	}
+	if len(model.NotificationIntegrationIDs) != 1 {
+		t.Errorf("expected 1 notification integration, got %d", len(model.NotificationIntegrationIDs))
+	}
+	if got := model.Runbook.InvestigationPrompt.ValueString(); got != "Investigate" {
+		t.Errorf("unexpected investigation prompt %q", got)
+	}
+}
+
+func TestAlertResponderUpgradeStateFromV005(t *testing.T) {
+	// State written by 0.0.4 and 0.0.5, before the runbook prompts were renamed
+	model := upgradeAlertResponderState(t, 0, `{
+  "id": "R3JhcGhRTEFsZXJ0UmVzcG9uZGVyOjI=",
+  "team_name": "Platform",
+  "name": "Slack Alerts",
+  "webhook_sources": null,
+  "slack_channel_id": "C07TUN1EFFU",
+  "matching_criteria": {"text_matches": ["database"], "slack_bot_app_user_id": "B01234567"},
+  "runbook": {"prompt": "Investigate the issue", "fast_prompt": "Assess impact"},
+  "notification_integration_ids": null,
+  "enabled": false,
+  "url": "",
+  "created_at": "2025-10-22T10:00:00Z",
+  "updated_at": "2025-10-22T10:00:00Z"
+}`)
+
+	if got := model.Runbook.InvestigationPrompt.ValueString(); got != "Investigate the issue" {
+		t.Errorf("expected prompt to become investigation_prompt, got %q", got)
+	}
+	if got := model.Runbook.ImpactAndSeverityPrompt.ValueString(); got != "Assess impact" {
+		t.Errorf("expected fast_prompt to become impact_and_severity_prompt, got %q", got)
+	}
+	if got := model.SlackChannelID.ValueString(); got != "C07TUN1EFFU" {
+		t.Errorf("unexpected slack_channel_id %q", got)
+	}
+	if model.WebhookSources != nil {
+		t.Errorf("expected webhook_sources to stay null, got %v", model.WebhookSources)
+	}
+	if model.Enabled.ValueBool() {
+		t.Error("expected enabled to stay false")
+	}
+}
+
+func TestAlertResponderUpgradeStateFromV003(t *testing.T) {
+	// State written by 0.0.3, before Slack alert responders existed
+	model := upgradeAlertResponderState(t, 0, `{
+  "id": "R3JhcGhRTEFsZXJ0UmVzcG9uZGVyOjM=",
+  "team_name": "Platform",
+  "name": "Initial Release",
+  "webhook_sources": [{"type": "ROOTLY", "remote_id": "rootly-1"}],
+  "matching_criteria": {"text_matches": ["error"]},
+  "runbook": {"prompt": "Investigate", "fast_prompt": null},
+  "notification_integration_ids": [],
+  "enabled": true,
+  "url": "https://app.tierzero.ai/alert-responders/R3JhcGhRTEFsZXJ0UmVzcG9uZGVyOjM=",
+  "created_at": "2025-10-21T10:00:00Z",
+  "updated_at": "2025-10-21T10:00:00Z"
+}`)
+
+	if !model.SlackChannelID.IsNull() || !model.MatchingCriteria.SlackBotAppUserID.IsNull() {
+		t.Error("expected Slack attributes added in 0.0.4 to be null")
+	}
+	if got := model.Runbook.InvestigationPrompt.ValueString(); got != "Investigate" {
+		t.Errorf("unexpected investigation prompt %q", got)
+	}
+	if !model.Runbook.ImpactAndSeverityPrompt.IsNull() {
+		t.Errorf("expected impact_and_severity_prompt to be null, got %s", model.Runbook.ImpactAndSeverityPrompt)
+	}
+}
+
+func TestAlertResponderStateMigrationsCoverEveryVersion(t *testing.T) {
+	if len(alertResponderStateMigrations) != alertResponderSchemaVersion {
+		t.Fatalf("schema version %d needs %d state migrations, got %d", alertResponderSchemaVersion, alertResponderSchemaVersion, len(alertResponderStateMigrations))
+	}
+
+	upgraders := (&alertResponderResource{}).UpgradeState(context.Background())
+	for version := range int64(alertResponderSchemaVersion) {
+		if _, ok := upgraders[version]; !ok {
+			t.Errorf("no state upgrader for version %d", version)
+		}
+	}
+}
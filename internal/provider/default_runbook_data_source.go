@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &defaultRunbookDataSource{}
+	_ datasource.DataSourceWithConfigure = &defaultRunbookDataSource{}
+)
+
+// NewDefaultRunbookDataSource is a helper function to simplify the provider implementation.
+func NewDefaultRunbookDataSource() datasource.DataSource {
+	return &defaultRunbookDataSource{}
+}
+
+// defaultRunbookDataSource is the data source implementation.
+type defaultRunbookDataSource struct {
+	client *client.Client
+}
+
+// defaultRunbookDataSourceModel maps the data source schema data.
+type defaultRunbookDataSourceModel struct {
+	InvestigationPrompt     types.String `tfsdk:"investigation_prompt"`
+	ImpactAndSeverityPrompt types.String `tfsdk:"impact_and_severity_prompt"`
+}
+
+// Metadata returns the data source type name.
+func (d *defaultRunbookDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_runbook"
+}
+
+// Schema defines the schema for the data source.
+func (d *defaultRunbookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the organization's default runbook, whose prompts are used by alert responders that do not set their own. Use it to extend the default prompts in the runbook of tierzero_alert_responder.",
+		Attributes: map[string]schema.Attribute{
+			"investigation_prompt": schema.StringAttribute{
+				Description: "Default investigation prompt, empty when not set",
+				Computed:    true,
+			},
+			"impact_and_severity_prompt": schema.StringAttribute{
+				Description: "Default impact and severity prompt, empty when not set",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *defaultRunbookDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *defaultRunbookDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Fetch the default runbook from API
+	runbook, err := d.client.GetDefaultRunbook(ctx)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Reading Default Runbook", "Could not read the default runbook: ", err)
+		return
+	}
+
+	// Map response to state
+	state := defaultRunbookDataSourceModel{
+		InvestigationPrompt:     types.StringValue(runbook.InvestigationPrompt),
+		ImpactAndSeverityPrompt: types.StringValue(runbook.ImpactAndSeverityPrompt),
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+func TestAccDefaultRunbookDataSource(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	server.SetDefaultRunbook(client.Runbook{InvestigationPrompt: "Check the recent deploys."})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `data "tierzero_default_runbook" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tierzero_default_runbook.test",
+						tfjsonpath.New("investigation_prompt"),
+						knownvalue.StringExact("Check the recent deploys."),
+					),
+					statecheck.ExpectKnownValue(
+						"data.tierzero_default_runbook.test",
+						tfjsonpath.New("impact_and_severity_prompt"),
+						knownvalue.StringExact(""),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deletedAlertRespondersDataSource{}
+	_ datasource.DataSourceWithConfigure = &deletedAlertRespondersDataSource{}
+)
+
+// NewDeletedAlertRespondersDataSource is a helper function to simplify the provider implementation.
+func NewDeletedAlertRespondersDataSource() datasource.DataSource {
+	return &deletedAlertRespondersDataSource{}
+}
+
+// deletedAlertRespondersDataSource is the data source implementation.
+type deletedAlertRespondersDataSource struct {
+	client *client.Client
+}
+
+// deletedAlertRespondersDataSourceModel maps the data source schema data.
+type deletedAlertRespondersDataSourceModel struct {
+	TeamName        types.String                 `tfsdk:"team_name"`
+	NamePrefix      types.String                 `tfsdk:"name_prefix"`
+	AlertResponders []deletedAlertResponderModel `tfsdk:"alert_responders"`
+}
+
+type deletedAlertResponderModel struct {
+	ID        types.String `tfsdk:"id"`
+	TeamName  types.String `tfsdk:"team_name"`
+	Name      types.String `tfsdk:"name"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	DeletedAt types.String `tfsdk:"deleted_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *deletedAlertRespondersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deleted_alert_responders"
+}
+
+// Schema defines the schema for the data source.
+func (d *deletedAlertRespondersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the organization's deleted alert responders. Deleted alert responders keep holding their name until they are purged, and can be restored with the restore_if_deleted attribute of tierzero_alert_responder.",
+		Attributes: map[string]schema.Attribute{
+			"team_name": schema.StringAttribute{
+				Description: "Optional filter by exact team name",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Optional filter by case-sensitive prefix of the alert responder name",
+				Optional:    true,
+			},
+			"alert_responders": schema.ListNestedAttribute{
+				Description: "List of deleted alert responders",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Alert responder Global ID",
+							Computed:    true,
+						},
+						"team_name": schema.StringAttribute{
+							Description: "Team name",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Alert responder name, still held by the deleted alert responder",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the alert responder was enabled when it was deleted, and will be once restored",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation timestamp (ISO 8601)",
+							Computed:    true,
+						},
+						"deleted_at": schema.StringAttribute{
+							Description: "Deletion timestamp (ISO 8601)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *deletedAlertRespondersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *deletedAlertRespondersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config deletedAlertRespondersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch deleted alert responders from API
+	alertResponders, err := d.client.ListDeletedAlertResponders(ctx, &client.ListDeletedAlertRespondersOptions{
+		TeamName:   config.TeamName.ValueString(),
+		NamePrefix: config.NamePrefix.ValueString(),
+	})
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Reading Deleted Alert Responders", "Could not read deleted alert responders: ", err)
+		return
+	}
+
+	// Map response to state
+	config.AlertResponders = make([]deletedAlertResponderModel, len(alertResponders))
+	for i, alertResponder := range alertResponders {
+		config.AlertResponders[i] = deletedAlertResponderModel{
+			ID:        types.StringValue(alertResponder.ID),
+			TeamName:  types.StringValue(alertResponder.TeamName),
+			Name:      types.StringValue(alertResponder.Name),
+			Enabled:   types.BoolValue(alertResponder.Status == "ACTIVE"),
+			CreatedAt: types.StringValue(alertResponder.CreatedAt),
+			DeletedAt: types.StringValue(alertResponder.DeletedAt),
+		}
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+func TestAccDeletedAlertRespondersDataSource(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	paused := server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "prod-paused", Status: "PAUSED"})
+	server.DeleteAlertResponder(paused.ID)
+	other := server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "staging"})
+	server.DeleteAlertResponder(other.ID)
+	otherTeam := server.PutAlertResponder(client.AlertResponder{TeamName: "Search", Name: "prod-search"})
+	server.DeleteAlertResponder(otherTeam.ID)
+	server.PutAlertResponder(client.AlertResponder{TeamName: "Platform", Name: "prod-live"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `data "tierzero_deleted_alert_responders" "all" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tierzero_deleted_alert_responders.all",
+						tfjsonpath.New("alert_responders"),
+						knownvalue.ListSizeExact(3),
+					),
+				},
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "tierzero_deleted_alert_responders" "prod" {
+  team_name   = "Platform"
+  name_prefix = "prod-"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tierzero_deleted_alert_responders.prod",
+						tfjsonpath.New("alert_responders"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"id":         knownvalue.StringExact(paused.ID),
+								"team_name":  knownvalue.StringExact("Platform"),
+								"name":       knownvalue.StringExact("prod-paused"),
+								"enabled":    knownvalue.Bool(false),
+								"created_at": knownvalue.StringExact(paused.CreatedAt),
+								"deleted_at": knownvalue.NotNull(),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
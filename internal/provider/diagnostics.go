@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+// schemaTypeAtPath is implemented by resource and data source schemas. It is
+// used to check that a field reported by the API exists in the schema.
+type schemaTypeAtPath interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// addAPIErrorDiagnostics reports an error returned by the client. Per-field
+// validation errors are attached to the matching attribute so Terraform can
+// point at the offending line of configuration; any field that does not map
+// onto the schema is reported together with the overall error.
+func addAPIErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, s schemaTypeAtPath, summary, detail string, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		diags.AddError(summary, detail+err.Error()+"\n\nThe operation timed out. Consider raising the resource's timeouts, or request_timeout in the provider configuration if single requests are slow.")
+		return
+	}
+
+	apiErr, ok := client.AsAPIError(err)
+	if !ok {
+		diags.AddError(summary, detail+err.Error())
+		return
+	}
+
+	switch {
+	case client.IsUnauthorized(err):
+		diags.AddError(summary, detail+err.Error()+"\n\nCheck that the provider api_key (or TIERZERO_API_KEY) is valid and has access to this organization.")
+		return
+	case client.IsQuotaExceeded(err):
+		diags.AddError(summary, detail+err.Error()+"\n\nThe organization hit an API quota or rate limit. Consider lowering requests_per_second or max_concurrent_requests in the provider configuration.")
+		return
+	}
+
+	unmapped := 0
+	for _, fe := range apiErr.FieldErrors {
+		p, ok := parseFieldPath(fe.Field)
+		truncated := false
+		if ok && s != nil {
+			p, truncated, ok = schemaFieldPath(ctx, s, p)
+		} else {
+			ok = false
+		}
+		if !ok {
+			unmapped++
+			continue
+		}
+
+		message := fe.Message
+		if truncated {
+			message = fe.Field + ": " + message
+		}
+		if apiErr.RequestID != "" {
+			message += " (request ID " + apiErr.RequestID + ")"
+		}
+		diags.AddAttributeError(p, summary, message)
+	}
+
+	// Always report the overall error unless every field error was attached
+	// to an attribute, in which case it would only repeat them.
+	if len(apiErr.FieldErrors) == 0 || unmapped > 0 {
+		diags.AddError(summary, detail+err.Error())
+	}
+}
+
+// schemaFieldPath checks that p exists in the schema. Set elements cannot be
+// addressed by index, so a path into an element of a set, such as
+// "webhook_sources[1].remote_id", is shortened to the set itself and
+// truncated is true.
+func schemaFieldPath(ctx context.Context, s schemaTypeAtPath, p path.Path) (resolved path.Path, truncated bool, ok bool) {
+	resolved = p
+	for q := p; len(q.Steps()) > 0; q = q.ParentPath() {
+		last, _ := q.Steps().LastStep()
+		if _, isIndex := last.(path.PathStepElementKeyInt); !isIndex {
+			continue
+		}
+		parent := q.ParentPath()
+		t, d := s.TypeAtPath(ctx, parent)
+		if d.HasError() {
+			continue
+		}
+		// Keep going up: the outermost set wins
+		if _, isSet := t.(types.SetType); isSet {
+			resolved, truncated = parent, true
+		}
+	}
+
+	_, d := s.TypeAtPath(ctx, resolved)
+	return resolved, truncated, !d.HasError()
+}
+
+// parseFieldPath converts an API field reference such as
+// "matching_criteria.text_matches[2]" into an attribute path.
+func parseFieldPath(field string) (path.Path, bool) {
+	if field == "" {
+		return path.Empty(), false
+	}
+
+	var p path.Path
+	for i, segment := range strings.Split(field, ".") {
+		name, rest, hasIndex := strings.Cut(segment, "[")
+		if name == "" || (hasIndex && rest == "") {
+			return path.Empty(), false
+		}
+		if i == 0 {
+			p = path.Root(name)
+		} else {
+			p = p.AtName(name)
+		}
+
+		// Handle one or more list indexes, e.g. "webhook_sources[0]"
+		for rest != "" {
+			index, after, found := strings.Cut(rest, "]")
+			if !found {
+				return path.Empty(), false
+			}
+			n, err := strconv.Atoi(index)
+			if err != nil || n < 0 {
+				return path.Empty(), false
+			}
+			if after != "" && !strings.HasPrefix(after, "[") {
+				return path.Empty(), false
+			}
+			p = p.AtListIndex(n)
+			rest = strings.TrimPrefix(after, "[")
+		}
+	}
+
+	return p, true
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+func TestSchemaFieldPath(t *testing.T) {
+	ctx := context.Background()
+	var resp resource.SchemaResponse
+	NewAlertResponderResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	tests := []struct {
+		field     string
+		want      path.Path
+		truncated bool
+		ok        bool
+	}{
+		{field: "name", want: path.Root("name"), ok: true},
+		{field: "matching_criteria.slack_bot_app_user_id", want: path.Root("matching_criteria").AtName("slack_bot_app_user_id"), ok: true},
+		{field: "matching_criteria.text_matches[2]", want: path.Root("matching_criteria").AtName("text_matches"), truncated: true, ok: true},
+		{field: "webhook_sources[1].remote_id", want: path.Root("webhook_sources"), truncated: true, ok: true},
+		{field: "notification_integration_ids[0]", want: path.Root("notification_integration_ids"), truncated: true, ok: true},
+		{field: "unknown_field", ok: false},
+		{field: "name[0]", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			p, ok := parseFieldPath(tt.field)
+			if !ok {
+				t.Fatalf("failed to parse %q", tt.field)
+			}
+			got, truncated, ok := schemaFieldPath(ctx, resp.Schema, p)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %t, got %t", tt.ok, ok)
+			}
+			if !ok {
+				return
+			}
+			if !got.Equal(tt.want) || truncated != tt.truncated {
+				t.Errorf("expected %s (truncated %t), got %s (truncated %t)", tt.want, tt.truncated, got, truncated)
+			}
+		})
+	}
+}
+
+// attributeErrors returns the error diagnostics attached to an attribute,
+// keyed by path, and counts the others
+func attributeErrors(diags diag.Diagnostics) (map[string]diag.Diagnostic, int) {
+	attached := map[string]diag.Diagnostic{}
+	other := 0
+	for _, d := range diags.Errors() {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			attached[withPath.Path().String()] = d
+		} else {
+			other++
+		}
+	}
+	return attached, other
+}
+
+func TestAddAPIErrorDiagnosticsAttachesFieldErrors(t *testing.T) {
+	ctx := context.Background()
+	var resp resource.SchemaResponse
+	NewAlertResponderResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	// A validation error as returned by the API for an alert responder create
+	server := tierzerotest.NewServer(t)
+	slackChannelID := "C123"
+	_, err := server.Client().CreateAlertResponder(ctx, &client.CreateAlertResponderRequest{
+		TeamName:         "Platform",
+		Name:             "Invalid",
+		SlackChannelID:   &slackChannelID,
+		MatchingCriteria: &client.MatchingCriteria{TextMatches: []string{"error", " "}},
+	})
+	if !client.IsValidationError(err) {
+		t.Fatalf("expected a validation error, got: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostics(ctx, &diags, resp.Schema, "Error Creating Alert Responder", "Could not create alert responder: ", err)
+
+	attached, other := attributeErrors(diags)
+	if other != 0 || len(attached) != 1 {
+		t.Fatalf("expected a single attribute error, got %v", diags)
+	}
+	// text_matches is a set, so the error is reported on the set itself
+	d, ok := attached[path.Root("matching_criteria").AtName("text_matches").String()]
+	if !ok {
+		t.Fatalf("expected an error on matching_criteria.text_matches, got %v", diags)
+	}
+	if !strings.HasPrefix(d.Detail(), "matching_criteria.text_matches[1]: ") || !strings.Contains(d.Detail(), "request ID") {
+		t.Errorf("expected the element and request ID in the detail, got %q", d.Detail())
+	}
+}
+
+func TestAddAPIErrorDiagnosticsReportsUnmappedFields(t *testing.T) {
+	ctx := context.Background()
+	var resp resource.SchemaResponse
+	NewAlertResponderResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	err := &client.APIError{
+		StatusCode: 422,
+		Code:       "VALIDATION_ERROR",
+		Message:    "invalid alert responder",
+		FieldErrors: []client.FieldError{
+			{Field: "matching_criteria.slack_bot_app_user_id", Message: "requires slack_channel_id"},
+			{Field: "not_in_schema", Message: "unexpected"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostics(ctx, &diags, resp.Schema, "Error Updating Alert Responder", "Could not update alert responder: ", err)
+
+	attached, other := attributeErrors(diags)
+	d, ok := attached[path.Root("matching_criteria").AtName("slack_bot_app_user_id").String()]
+	if !ok || d.Detail() != "requires slack_channel_id" {
+		t.Errorf("expected an error on matching_criteria.slack_bot_app_user_id, got %v", diags)
+	}
+	// The field missing from the schema is only reported in the overall error
+	if len(attached) != 1 || other != 1 {
+		t.Errorf("expected 1 attribute error and the overall error, got %v", diags)
+	}
+}
@@ -117,10 +117,7 @@ func (d *notificationIntegrationsDataSource) Read(ctx context.Context, req datas
 	// Fetch notification integrations from API
 	integrations, err := d.client.ListNotificationIntegrations(ctx, kind)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Notification Integrations",
-			"Could not read notification integrations: "+err.Error(),
-		)
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Reading Notification Integrations", "Could not read notification integrations: ", err)
 		return
 	}
 
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+func TestAccNotificationIntegrationsDataSource(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	slack := server.AddNotificationIntegration(client.NotificationIntegration{Name: "#incidents", Kind: "SLACK_ALERT"})
+	server.AddNotificationIntegration(client.NotificationIntegration{Name: "Discord alerts", Kind: "DISCORD_WEBHOOK"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `data "tierzero_notification_integrations" "all" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tierzero_notification_integrations.all",
+						tfjsonpath.New("notification_integrations"),
+						knownvalue.ListSizeExact(2),
+					),
+				},
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "tierzero_notification_integrations" "slack" {
+  kind = "SLACK_ALERT"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tierzero_notification_integrations.slack",
+						tfjsonpath.New("notification_integrations"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"id":         knownvalue.StringExact(slack.ID),
+								"name":       knownvalue.StringExact("#incidents"),
+								"kind":       knownvalue.StringExact("SLACK_ALERT"),
+								"created_at": knownvalue.StringExact(slack.CreatedAt),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccNotificationIntegrationsDataSource_unauthorized(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	config := strings.Replace(server.ProviderConfig(), server.APIKey, "wrong-key", 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config + `data "tierzero_notification_integrations" "all" {}`,
+				ExpectError: regexp.MustCompile(`api_key`),
+			},
+		},
+	})
+}
@@ -3,19 +3,29 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tierzero/terraform-provider-tierzero/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &TierZeroProvider{}
+	_ provider.Provider                  = &TierZeroProvider{}
+	_ provider.ProviderWithListResources = &TierZeroProvider{}
 )
 
 // New creates a new TierZero provider instance
@@ -36,8 +46,72 @@ type TierZeroProvider struct {
 
 // TierZeroProviderModel describes the provider data model
 type TierZeroProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	BaseURL types.String `tfsdk:"base_url"`
+	APIKey                types.String  `tfsdk:"api_key"`
+	BaseURL               types.String  `tfsdk:"base_url"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait          types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestTimeout        types.Int64   `tfsdk:"request_timeout"`
+	DeletionProtection    types.Bool    `tfsdk:"deletion_protection"`
+}
+
+// providerResourceData is passed to resources. Data sources and list
+// resources only receive the client.
+type providerResourceData struct {
+	Client *client.Client
+	// IgnoreDeletionProtection is set by deletion_protection = false in the
+	// provider configuration, allowing resources to be deleted regardless of
+	// their own deletion_protection
+	IgnoreDeletionProtection bool
+	// DefaultRunbook is read once for every alert responder planned by this
+	// provider instance
+	DefaultRunbook *defaultRunbookCache
+}
+
+// defaultRunbookCache reads the organization's default runbook on first use
+// and keeps the result for the provider instance, so planning many alert
+// responders sends a single request. A failure is only kept when the API
+// rejected the request: after a network error, a timeout, a cancellation, a
+// 429 or a 5xx response, the next plan reads it again.
+type defaultRunbookCache struct {
+	client *client.Client
+
+	mu      sync.Mutex
+	fetched bool
+	runbook *client.Runbook
+	err     error
+}
+
+// get returns the default runbook, reading it if needed
+func (c *defaultRunbookCache) get(ctx context.Context) (*client.Runbook, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fetched {
+		return c.runbook, c.err
+	}
+	runbook, err := c.client.GetDefaultRunbook(ctx)
+	if err != nil && !isPermanentAPIError(err) {
+		return nil, err
+	}
+	c.runbook, c.err = runbook, err
+	c.fetched = true
+	return c.runbook, c.err
+}
+
+// isPermanentAPIError reports whether the API answered a request with an
+// error that sending it again would not change
+func isPermanentAPIError(err error) bool {
+	apiErr, ok := client.AsAPIError(err)
+	return ok && apiErr.StatusCode != http.StatusTooManyRequests && apiErr.StatusCode < http.StatusInternalServerError
+}
+
+// isKnown reports whether a provider attribute is set to a known value. An
+// unknown value, for example one taken from a resource that is not created
+// yet, would read as zero and disable the setting, so the default is kept.
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
 }
 
 // Metadata returns the provider type name
@@ -60,6 +134,45 @@ func (p *TierZeroProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Description: "TierZero API base URL. Defaults to https://api.tierzero.ai",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried after a transient failure (network error, HTTP 429 or 5xx). Set to 0 to disable retries. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "Maximum number of seconds to wait between two retries, including waits requested by the API through the Retry-After header. Defaults to 30.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum average number of API requests per second, shared by every resource and data source of this provider instance. Set to 0 to disable rate limiting. Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of API requests in flight at the same time, shared by every resource and data source of this provider instance. Set to 0 to disable the limit. Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Maximum number of seconds a single API request may take, each retry getting its own. Set to 0 to only rely on the operation timeouts of resources. Defaults to 30.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Set to false to let Terraform delete alert responders regardless of their deletion_protection attribute, for example in ephemeral test environments. Defaults to true, leaving each alert responder's deletion_protection in effect.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -96,11 +209,47 @@ func (p *TierZeroProvider) Configure(ctx context.Context, req provider.Configure
 	// Create client and make it available to resources and data sources
 	apiClient := client.NewClient(baseURL, apiKey)
 
+	// Configure retry behavior
+	if isKnown(config.MaxRetries) {
+		apiClient.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if isKnown(config.RetryMaxWait) {
+		apiClient.RetryWaitMax = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+		apiClient.RetryWaitMin = min(apiClient.RetryWaitMin, apiClient.RetryWaitMax)
+	}
+
+	if isKnown(config.RequestTimeout) {
+		apiClient.HTTPClient.Timeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	// Configure client-side throttling
+	if isKnown(config.RequestsPerSecond) {
+		apiClient.SetRateLimit(config.RequestsPerSecond.ValueFloat64())
+	}
+	if isKnown(config.MaxConcurrentRequests) {
+		apiClient.SetMaxConcurrentRequests(int(config.MaxConcurrentRequests.ValueInt64()))
+	}
+
 	// Set User-Agent header to identify Terraform provider requests
 	apiClient.UserAgent = fmt.Sprintf("terraform-provider-tierzero/%s (+https://www.terraform.io)", p.version)
 
+	// Optionally record every API exchange into a HAR file for support tickets
+	if harFile := os.Getenv("TIERZERO_HTTP_HAR_FILE"); harFile != "" {
+		if err := apiClient.EnableHARRecording(harFile, p.version); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Record HTTP Archive",
+				fmt.Sprintf("TIERZERO_HTTP_HAR_FILE is set but %q could not be written: %s", harFile, err),
+			)
+		}
+	}
+
 	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	resp.ResourceData = &providerResourceData{
+		Client:                   apiClient,
+		IgnoreDeletionProtection: isKnown(config.DeletionProtection) && !config.DeletionProtection.ValueBool(),
+		DefaultRunbook:           &defaultRunbookCache{client: apiClient},
+	}
+	resp.ListResourceData = apiClient
 }
 
 // DataSources defines the data sources implemented in the provider
@@ -108,6 +257,8 @@ func (p *TierZeroProvider) DataSources(_ context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		NewWebhookSubscriptionsDataSource,
 		NewNotificationIntegrationsDataSource,
+		NewDeletedAlertRespondersDataSource,
+		NewDefaultRunbookDataSource,
 	}
 }
 
@@ -117,3 +268,10 @@ func (p *TierZeroProvider) Resources(_ context.Context) []func() resource.Resour
 		NewAlertResponderResource,
 	}
 }
+
+// ListResources defines the list resources implemented in the provider
+func (p *TierZeroProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAlertResponderListResource,
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+// testAccProtoV6ProviderFactories instantiates the provider in process for
+// acceptance tests. Tests point it at a tierzerotest.Server through the
+// provider configuration, so no TierZero organization is needed.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"tierzero": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// configureProvider configures a provider with the given attribute values,
+// the other attributes being null
+func configureProvider(t *testing.T, values map[string]tftypes.Value) *providerResourceData {
+	t.Helper()
+	ctx := context.Background()
+
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{"api_key": tftypes.NewValue(tftypes.String, "test-api-key")}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		} else if _, ok := attributes[name]; !ok {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure error: %v", resp.Diagnostics)
+	}
+	return resp.ResourceData.(*providerResourceData)
+}
+
+// Values that are unknown while planning, for example taken from a resource
+// attribute, keep the defaults rather than reading as zero
+func TestConfigureKeepsDefaultsForUnknownValues(t *testing.T) {
+	unknownNumber := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+	data := configureProvider(t, map[string]tftypes.Value{
+		"max_retries":             unknownNumber,
+		"retry_max_wait":          unknownNumber,
+		"requests_per_second":     unknownNumber,
+		"max_concurrent_requests": unknownNumber,
+		"request_timeout":         unknownNumber,
+		"deletion_protection":     tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+	})
+
+	if data.Client.MaxRetries != 3 {
+		t.Errorf("expected the default max_retries, got %d", data.Client.MaxRetries)
+	}
+	if data.Client.RetryWaitMax != 30*time.Second {
+		t.Errorf("expected the default retry_max_wait, got %s", data.Client.RetryWaitMax)
+	}
+	if data.Client.RateLimit() != 10 {
+		t.Errorf("expected the default requests_per_second, got %v", data.Client.RateLimit())
+	}
+	if data.Client.MaxConcurrentRequests() != 10 {
+		t.Errorf("expected the default max_concurrent_requests, got %d", data.Client.MaxConcurrentRequests())
+	}
+	if data.Client.HTTPClient.Timeout != 30*time.Second {
+		t.Errorf("expected the default request_timeout, got %s", data.Client.HTTPClient.Timeout)
+	}
+	if data.IgnoreDeletionProtection {
+		t.Error("expected the deletion_protection of alert responders to stay in effect")
+	}
+}
+
+func TestConfigureAppliesKnownValues(t *testing.T) {
+	data := configureProvider(t, map[string]tftypes.Value{
+		"max_retries":             tftypes.NewValue(tftypes.Number, 0),
+		"retry_max_wait":          tftypes.NewValue(tftypes.Number, 5),
+		"requests_per_second":     tftypes.NewValue(tftypes.Number, 0),
+		"max_concurrent_requests": tftypes.NewValue(tftypes.Number, 0),
+		"request_timeout":         tftypes.NewValue(tftypes.Number, 0),
+		"deletion_protection":     tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	if data.Client.MaxRetries != 0 {
+		t.Errorf("expected retries to be disabled, got max_retries %d", data.Client.MaxRetries)
+	}
+	if data.Client.RetryWaitMax != 5*time.Second {
+		t.Errorf("expected retry_max_wait of 5s, got %s", data.Client.RetryWaitMax)
+	}
+	if data.Client.RateLimit() != 0 || data.Client.MaxConcurrentRequests() != 0 {
+		t.Errorf("expected the limits to be disabled, got %v requests per second and %d concurrent requests",
+			data.Client.RateLimit(), data.Client.MaxConcurrentRequests())
+	}
+	if data.Client.HTTPClient.Timeout != 0 {
+		t.Errorf("expected no request timeout, got %s", data.Client.HTTPClient.Timeout)
+	}
+	if !data.IgnoreDeletionProtection {
+		t.Error("expected deletion_protection = false to override alert responders")
+	}
+}
+
+// defaultRunbookRequests counts the requests for the default runbook
+func defaultRunbookRequests(server *tierzerotest.Server) int {
+	count := 0
+	for _, request := range server.Requests() {
+		if request.Path == "/api/v1/default-runbook" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestDefaultRunbookCacheReadsOnce(t *testing.T) {
+	ctx := context.Background()
+	server := tierzerotest.NewServer(t)
+	server.SetDefaultRunbook(client.Runbook{InvestigationPrompt: "Check the recent deploys."})
+	cache := &defaultRunbookCache{client: server.Client()}
+
+	for range 3 {
+		runbook, err := cache.get(ctx)
+		if err != nil {
+			t.Fatalf("failed to get the default runbook: %s", err)
+		}
+		if runbook.InvestigationPrompt != "Check the recent deploys." {
+			t.Errorf("unexpected default runbook %+v", runbook)
+		}
+	}
+	if got := defaultRunbookRequests(server); got != 1 {
+		t.Errorf("expected a single request, got %d", got)
+	}
+}
+
+func TestDefaultRunbookCacheRetriesTransientFailures(t *testing.T) {
+	ctx := context.Background()
+	server := tierzerotest.NewServer(t)
+	server.SetDefaultRunbook(client.Runbook{InvestigationPrompt: "Check the recent deploys."})
+	server.FailNext(http.MethodGet, "/api/v1/default-runbook", http.StatusInternalServerError, 0)
+	cache := &defaultRunbookCache{client: server.Client()}
+
+	if _, err := cache.get(ctx); err == nil {
+		t.Fatal("expected an error")
+	}
+	server.ClearFaults()
+	runbook, err := cache.get(ctx)
+	if err != nil {
+		t.Fatalf("expected the default runbook to be read again, got %s", err)
+	}
+	if runbook.InvestigationPrompt != "Check the recent deploys." {
+		t.Errorf("unexpected default runbook %+v", runbook)
+	}
+
+	// A canceled read is not kept either
+	cache = &defaultRunbookCache{client: server.Client()}
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := cache.get(canceled); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := cache.get(ctx); err != nil {
+		t.Fatalf("expected the default runbook to be read again, got %s", err)
+	}
+}
+
+func TestDefaultRunbookCacheKeepsPermanentFailures(t *testing.T) {
+	ctx := context.Background()
+	server := tierzerotest.NewServer(t)
+	server.FailNext(http.MethodGet, "/api/v1/default-runbook", http.StatusForbidden, 1)
+	cache := &defaultRunbookCache{client: server.Client()}
+
+	if _, err := cache.get(ctx); err == nil {
+		t.Fatal("expected an error")
+	}
+	requests := defaultRunbookRequests(server)
+	if _, err := cache.get(ctx); err == nil {
+		t.Fatal("expected the error to be kept")
+	}
+	if got := defaultRunbookRequests(server); got != requests {
+		t.Errorf("expected no further request, got %d after %d", got, requests)
+	}
+}
@@ -96,10 +96,7 @@ func (d *webhookSubscriptionsDataSource) Read(ctx context.Context, req datasourc
 	// Fetch webhook subscriptions from API
 	subscriptions, err := d.client.ListWebhookSubscriptions(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Webhook Subscriptions",
-			"Could not read webhook subscriptions: "+err.Error(),
-		)
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, nil, "Error Reading Webhook Subscriptions", "Could not read webhook subscriptions: ", err)
 		return
 	}
 
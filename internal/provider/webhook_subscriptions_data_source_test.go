@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+	"github.com/tierzero/terraform-provider-tierzero/internal/tierzerotest"
+)
+
+func TestAccWebhookSubscriptionsDataSource(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	server.AddWebhookSubscription(client.WebhookSubscription{Type: "PAGERDUTY", RemoteID: "PABC123", Name: "Production"})
+	server.AddWebhookSubscription(client.WebhookSubscription{Type: "OPSGENIE", RemoteID: "og-456", Name: "Staging"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `data "tierzero_webhook_subscriptions" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tierzero_webhook_subscriptions.test",
+						tfjsonpath.New("webhook_subscriptions"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":      knownvalue.StringExact("PAGERDUTY"),
+								"remote_id": knownvalue.StringExact("PABC123"),
+								"name":      knownvalue.StringExact("Production"),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":      knownvalue.StringExact("OPSGENIE"),
+								"remote_id": knownvalue.StringExact("og-456"),
+								"name":      knownvalue.StringExact("Staging"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccWebhookSubscriptionsDataSource_requestTimeout(t *testing.T) {
+	server := tierzerotest.NewServer(t)
+	server.DelayNext(http.MethodGet, "/api/v1/webhook-subscriptions", 5*time.Second, 0)
+	config := strings.Replace(server.ProviderConfig(), "retry_max_wait = 1", "retry_max_wait = 1\n  max_retries = 0\n  request_timeout = 1", 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config + `data "tierzero_webhook_subscriptions" "test" {}`,
+				ExpectError: regexp.MustCompile(`request_timeout`),
+			},
+		},
+	})
+}
@@ -0,0 +1,610 @@
+package tierzerotest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+const (
+	// OrganizationName is the organization every fake alert responder belongs to
+	OrganizationName = "tierzerotest"
+	// AppURL is the base of the alert responder URLs returned by the server
+	AppURL = "https://app.tierzero.test"
+
+	statusActive = "ACTIVE"
+	statusPaused = "PAUSED"
+)
+
+// webhookTypes are the accepted webhook source types
+var webhookTypes = []string{"PAGERDUTY", "OPSGENIE", "FIREHYDRANT", "ROOTLY"}
+
+// updatableFields are the fields accepted by the update endpoint
+var updatableFields = []string{
+	"name",
+	"matching_criteria",
+	"webhook_sources",
+	"slack_channel_id",
+	"runbook",
+	"notification_integration_ids",
+}
+
+// AlertResponder returns the alert responder with the given ID. Soft-deleted
+// responders are not returned.
+func (s *Server) AlertResponder(id string) (client.AlertResponder, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(id)
+	if stored == nil || stored.deleted {
+		return client.AlertResponder{}, false
+	}
+	return copyAlertResponder(stored.AlertResponder), true
+}
+
+// AlertResponders returns every alert responder that is not soft deleted
+func (s *Server) AlertResponders() []client.AlertResponder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []client.AlertResponder
+	for _, stored := range s.alertResponders {
+		if !stored.deleted {
+			result = append(result, copyAlertResponder(stored.AlertResponder))
+		}
+	}
+	return result
+}
+
+// IsDeleted reports whether the alert responder exists and is soft deleted
+func (s *Server) IsDeleted(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(id)
+	return stored != nil && stored.deleted
+}
+
+// PutAlertResponder stores an alert responder without validation, as if it
+// had been created in the TierZero UI. ID, status, URL and timestamps are
+// filled in when empty.
+func (s *Server) PutAlertResponder(ar client.AlertResponder) client.AlertResponder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.newAlertResponder(ar)
+	return copyAlertResponder(stored.AlertResponder)
+}
+
+// ModifyAlertResponder changes an alert responder out of band, e.g. to
+// simulate drift. It returns false if the responder does not exist.
+func (s *Server) ModifyAlertResponder(id string, modify func(*client.AlertResponder)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(id)
+	if stored == nil || stored.deleted {
+		return false
+	}
+	modify(&stored.AlertResponder)
+	stored.UpdatedAt = s.timestamp()
+	return true
+}
+
+// DeleteAlertResponder soft deletes an alert responder out of band. It
+// returns false if the responder does not exist.
+func (s *Server) DeleteAlertResponder(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(id)
+	if stored == nil || stored.deleted {
+		return false
+	}
+	stored.deleted = true
+	stored.DeletedAt = s.timestamp()
+	return true
+}
+
+func (s *Server) createAlertResponder(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateAlertResponderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "invalid JSON body: "+err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.Header.Get("Idempotency-Key")
+	if id, ok := s.idempotencyKeys[key]; ok && key != "" {
+		writeJSON(w, http.StatusOK, s.findAlertResponder(id).AlertResponder)
+		return
+	}
+
+	ar := client.AlertResponder{
+		TeamName:                   req.TeamName,
+		Name:                       req.Name,
+		Runbook:                    req.Runbook,
+		MatchingCriteria:           req.MatchingCriteria,
+		WebhookSources:             req.WebhookSources,
+		SlackChannelID:             req.SlackChannelID,
+		NotificationIntegrationIDs: req.NotificationIntegrationIDs,
+		Status:                     req.Status,
+	}
+	if s.ignoreCreateStatus {
+		ar.Status = ""
+	}
+	if fieldErrors := s.validateAlertResponder(&ar); len(fieldErrors) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "invalid alert responder", fieldErrors)
+		return
+	}
+	s.applyDefaultRunbook(&ar)
+
+	if existing := s.findAlertResponderByName(req.TeamName, req.Name, ""); existing != nil {
+		switch {
+		case existing.deleted:
+			writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("name %q is held by a deleted alert responder", req.Name), nil)
+		case key != "":
+			writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("an alert responder named %q already exists in team %q", req.Name, req.TeamName), nil)
+		default:
+			// Duplicate names are idempotent: the existing responder is returned
+			writeJSON(w, http.StatusOK, existing.AlertResponder)
+		}
+		return
+	}
+
+	stored := s.newAlertResponder(ar)
+	if key != "" {
+		s.idempotencyKeys[key] = stored.ID
+	}
+	writeJSON(w, http.StatusCreated, stored.AlertResponder)
+}
+
+func (s *Server) getAlertResponder(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(r.PathValue("id"))
+	if stored == nil || stored.deleted {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, withoutURL(stored.AlertResponder))
+}
+
+// listAlertResponders lists either the live or the soft-deleted alert
+// responders. Deleted responders are listed without their URL.
+func (s *Server) listAlertResponders(deleted bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		pageSize := s.PageSize
+		if value := query.Get("page_size"); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "invalid page_size", nil)
+				return
+			}
+			pageSize = n
+		}
+
+		offset := 0
+		if cursor := query.Get("cursor"); cursor != "" {
+			n, err := decodeCursor(cursor)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "invalid cursor", nil)
+				return
+			}
+			offset = n
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		matches := []client.AlertResponder{}
+		for _, stored := range s.alertResponders {
+			if stored.deleted != deleted || !matchesListFilters(&stored.AlertResponder, query) {
+				continue
+			}
+			if deleted {
+				matches = append(matches, withoutURL(stored.AlertResponder))
+			} else {
+				matches = append(matches, stored.AlertResponder)
+			}
+		}
+
+		response := client.ListAlertRespondersResponse{
+			AlertResponders: []client.AlertResponder{},
+		}
+		if offset < len(matches) {
+			end := min(offset+pageSize, len(matches))
+			response.AlertResponders = matches[offset:end]
+			if end < len(matches) {
+				response.NextCursor = encodeCursor(end)
+			}
+		}
+		writeJSON(w, http.StatusOK, response)
+	}
+}
+
+func (s *Server) updateAlertResponder(w http.ResponseWriter, r *http.Request) {
+	var patch map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "invalid JSON body: "+err.Error(), nil)
+		return
+	}
+
+	var fieldErrors []client.FieldError
+	for field := range patch {
+		if !slices.Contains(updatableFields, field) {
+			fieldErrors = append(fieldErrors, client.FieldError{Field: field, Message: "field cannot be updated"})
+		}
+	}
+	if len(fieldErrors) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "invalid update", fieldErrors)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(r.PathValue("id"))
+	if stored == nil || stored.deleted {
+		writeNotFound(w)
+		return
+	}
+
+	updated, err := applyMergePatch(stored.AlertResponder, patch)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	if isSlack(&updated) != isSlack(&stored.AlertResponder) {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "cannot switch an alert responder between webhook and Slack sources", nil)
+		return
+	}
+	if fieldErrors := s.validateAlertResponder(&updated); len(fieldErrors) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "invalid alert responder", fieldErrors)
+		return
+	}
+	if s.findAlertResponderByName(updated.TeamName, updated.Name, updated.ID) != nil {
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("an alert responder named %q already exists in team %q", updated.Name, updated.TeamName), nil)
+		return
+	}
+
+	s.applyDefaultRunbook(&updated)
+	updated.UpdatedAt = s.timestamp()
+	stored.AlertResponder = updated
+	writeJSON(w, http.StatusOK, stored.AlertResponder)
+}
+
+func (s *Server) deleteAlertResponder(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(r.PathValue("id"))
+	if r.URL.Query().Get("purge") == "true" {
+		if stored == nil {
+			writeNotFound(w)
+			return
+		}
+		s.purgeAlertResponder(stored.ID)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if stored == nil || stored.deleted {
+		writeNotFound(w)
+		return
+	}
+	stored.deleted = true
+	stored.DeletedAt = s.timestamp()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) restoreAlertResponder(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := s.findAlertResponder(r.PathValue("id"))
+	if stored == nil {
+		writeNotFound(w)
+		return
+	}
+	if stored.deleted {
+		stored.deleted = false
+		stored.DeletedAt = ""
+		stored.UpdatedAt = s.timestamp()
+	}
+	writeJSON(w, http.StatusOK, withoutURL(stored.AlertResponder))
+}
+
+// purgeAlertResponder removes an alert responder for good, along with the
+// idempotency keys that would return it. The caller must hold s.mu.
+func (s *Server) purgeAlertResponder(id string) {
+	s.alertResponders = slices.DeleteFunc(s.alertResponders, func(stored *storedAlertResponder) bool {
+		return stored.ID == id
+	})
+	for key, keyID := range s.idempotencyKeys {
+		if keyID == id {
+			delete(s.idempotencyKeys, key)
+		}
+	}
+}
+
+func (s *Server) setAlertResponderStatus(status string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		stored := s.findAlertResponder(r.PathValue("id"))
+		if stored == nil || stored.deleted {
+			writeNotFound(w)
+			return
+		}
+		if stored.Status != status {
+			stored.Status = status
+			stored.UpdatedAt = s.timestamp()
+		}
+		writeJSON(w, http.StatusOK, withoutURL(stored.AlertResponder))
+	}
+}
+
+// newAlertResponder stores a new alert responder. The caller must hold s.mu.
+func (s *Server) newAlertResponder(ar client.AlertResponder) *storedAlertResponder {
+	s.nextID++
+	if ar.ID == "" {
+		ar.ID = globalID("AlertResponder", s.nextID)
+	}
+	if ar.OrganizationName == "" {
+		ar.OrganizationName = OrganizationName
+	}
+	if ar.Status == "" {
+		ar.Status = statusActive
+	}
+	if ar.URL == "" {
+		ar.URL = fmt.Sprintf("%s/alert-responders/%s", AppURL, ar.ID)
+	}
+	if ar.CreatedAt == "" {
+		ar.CreatedAt = s.timestamp()
+	}
+	if ar.UpdatedAt == "" {
+		ar.UpdatedAt = ar.CreatedAt
+	}
+
+	stored := &storedAlertResponder{AlertResponder: copyAlertResponder(ar)}
+	s.alertResponders = append(s.alertResponders, stored)
+	return stored
+}
+
+// findAlertResponder returns the responder with the given ID, including soft
+// deleted ones. The caller must hold s.mu.
+func (s *Server) findAlertResponder(id string) *storedAlertResponder {
+	for _, stored := range s.alertResponders {
+		if stored.ID == id {
+			return stored
+		}
+	}
+	return nil
+}
+
+// findAlertResponderByName returns the responder holding a name in a team,
+// including soft deleted ones, ignoring the responder with ID excludeID.
+// The caller must hold s.mu.
+func (s *Server) findAlertResponderByName(teamName, name, excludeID string) *storedAlertResponder {
+	for _, stored := range s.alertResponders {
+		if stored.TeamName == teamName && stored.Name == name && stored.ID != excludeID {
+			return stored
+		}
+	}
+	return nil
+}
+
+// validateAlertResponder returns the field errors of an alert responder.
+// The caller must hold s.mu.
+func (s *Server) validateAlertResponder(ar *client.AlertResponder) []client.FieldError {
+	var errs []client.FieldError
+	add := func(field, message string) {
+		errs = append(errs, client.FieldError{Field: field, Message: message})
+	}
+
+	if ar.TeamName == "" {
+		add("team_name", "team_name is required")
+	}
+	if ar.Name == "" {
+		add("name", "name is required")
+	}
+
+	if ar.Status != "" && ar.Status != statusActive && ar.Status != statusPaused {
+		add("status", fmt.Sprintf("unsupported status %q", ar.Status))
+	}
+
+	if ar.MatchingCriteria == nil || len(ar.MatchingCriteria.TextMatches) == 0 {
+		add("matching_criteria.text_matches", "at least one text match is required")
+	} else {
+		for i, match := range ar.MatchingCriteria.TextMatches {
+			if strings.TrimSpace(match) == "" {
+				add(fmt.Sprintf("matching_criteria.text_matches[%d]", i), "text match must not be empty")
+			}
+		}
+	}
+
+	hasSlackChannel := ar.SlackChannelID != nil && *ar.SlackChannelID != ""
+	switch {
+	case len(ar.WebhookSources) == 0 && !hasSlackChannel:
+		add("webhook_sources", "either webhook_sources or slack_channel_id is required")
+	case len(ar.WebhookSources) > 0 && hasSlackChannel:
+		add("slack_channel_id", "webhook_sources and slack_channel_id are mutually exclusive")
+	}
+
+	for i, source := range ar.WebhookSources {
+		if !slices.Contains(webhookTypes, source.Type) {
+			add(fmt.Sprintf("webhook_sources[%d].type", i), fmt.Sprintf("unsupported webhook type %q", source.Type))
+		}
+		if source.RemoteID == "" {
+			add(fmt.Sprintf("webhook_sources[%d].remote_id", i), "remote_id is required")
+		} else if len(s.webhookSubscriptions) > 0 && !s.hasWebhookSubscription(source) {
+			add(fmt.Sprintf("webhook_sources[%d].remote_id", i), fmt.Sprintf("no %s webhook subscription with remote ID %q", source.Type, source.RemoteID))
+		}
+	}
+
+	if ar.MatchingCriteria != nil && ar.MatchingCriteria.SlackBotAppUserID != nil && !hasSlackChannel {
+		add("matching_criteria.slack_bot_app_user_id", "slack_bot_app_user_id is only supported for Slack alert responders")
+	}
+
+	for i, id := range ar.NotificationIntegrationIDs {
+		if !s.hasNotificationIntegration(id) {
+			add(fmt.Sprintf("notification_integration_ids[%d]", i), fmt.Sprintf("unknown notification integration %q", id))
+		}
+	}
+
+	return errs
+}
+
+// matchesListFilters applies the list endpoint query filters
+func matchesListFilters(ar *client.AlertResponder, query map[string][]string) bool {
+	get := func(key string) string {
+		if values := query[key]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	if teamName := get("team_name"); teamName != "" && ar.TeamName != teamName {
+		return false
+	}
+	if status := get("status"); status != "" && ar.Status != status {
+		return false
+	}
+	if prefix := get("name_prefix"); prefix != "" && !strings.HasPrefix(ar.Name, prefix) {
+		return false
+	}
+	if sourceType := get("source_type"); sourceType != "" {
+		if sourceType == "SLACK" {
+			return isSlack(ar)
+		}
+		return slices.ContainsFunc(ar.WebhookSources, func(source client.WebhookSource) bool {
+			return source.Type == sourceType
+		})
+	}
+	return true
+}
+
+// applyMergePatch applies a JSON Merge Patch (RFC 7396) to an alert responder
+func applyMergePatch(ar client.AlertResponder, patch map[string]interface{}) (client.AlertResponder, error) {
+	data, err := json.Marshal(ar)
+	if err != nil {
+		return ar, err
+	}
+	var target map[string]interface{}
+	if err := json.Unmarshal(data, &target); err != nil {
+		return ar, err
+	}
+
+	merged, err := json.Marshal(mergePatch(target, patch))
+	if err != nil {
+		return ar, err
+	}
+	var result client.AlertResponder
+	if err := json.Unmarshal(merged, &result); err != nil {
+		return ar, fmt.Errorf("invalid update: %w", err)
+	}
+	return result, nil
+}
+
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
+
+// applyDefaultRunbook fills the runbook prompts that are not set from the
+// default runbook
+func (s *Server) applyDefaultRunbook(ar *client.AlertResponder) {
+	runbook := client.Runbook{}
+	if ar.Runbook != nil {
+		runbook = *ar.Runbook
+	}
+	if runbook.InvestigationPrompt == "" {
+		runbook.InvestigationPrompt = s.defaultRunbook.InvestigationPrompt
+	}
+	if runbook.ImpactAndSeverityPrompt == "" {
+		runbook.ImpactAndSeverityPrompt = s.defaultRunbook.ImpactAndSeverityPrompt
+	}
+	ar.Runbook = nil
+	if runbook != (client.Runbook{}) {
+		ar.Runbook = &runbook
+	}
+}
+
+func (s *Server) hasWebhookSubscription(source client.WebhookSource) bool {
+	return slices.ContainsFunc(s.webhookSubscriptions, func(sub client.WebhookSubscription) bool {
+		return sub.Type == source.Type && sub.RemoteID == source.RemoteID
+	})
+}
+
+func (s *Server) hasNotificationIntegration(id string) bool {
+	return slices.ContainsFunc(s.notificationIntegrations, func(integration client.NotificationIntegration) bool {
+		return integration.ID == id
+	})
+}
+
+func isSlack(ar *client.AlertResponder) bool {
+	return ar.SlackChannelID != nil && *ar.SlackChannelID != ""
+}
+
+// withoutURL mirrors the API, which only returns the URL from create,
+// update and list
+func withoutURL(ar client.AlertResponder) client.AlertResponder {
+	ar.URL = ""
+	return ar
+}
+
+// copyAlertResponder returns a deep copy so callers cannot mutate server state
+func copyAlertResponder(ar client.AlertResponder) client.AlertResponder {
+	data, _ := json.Marshal(ar)
+	var result client.AlertResponder
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := strings.CutPrefix(string(data), "offset:")
+	if !ok {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+	return strconv.Atoi(value)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "alert responder not found", nil)
+}
@@ -0,0 +1,131 @@
+package tierzerotest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fault makes matching requests fail with the given status code instead of
+// being handled normally, or delays them
+type Fault struct {
+	// Method matches the request method; empty matches any method
+	Method string
+	// Path matches the request path exactly, or as a prefix when it ends
+	// with "*"; empty matches any path
+	Path string
+	// StatusCode is the HTTP status returned, e.g. 404, 409, 429 or 500.
+	// Zero handles the request normally, which is useful with Delay.
+	StatusCode int
+	// Code is the error code in the response envelope; a code derived from
+	// StatusCode is used when empty
+	Code string
+	// RetryAfter sets the Retry-After response header when not empty
+	RetryAfter string
+	// Times is the number of requests to fail; zero fails every request
+	Times int
+	// AfterProcessing handles the request normally before returning the
+	// fault, simulating a response lost in transit
+	AfterProcessing bool
+	// Delay holds the request before answering it, simulating a slow API.
+	// The wait ends early if the client gives up on the request.
+	Delay time.Duration
+
+	hits int
+}
+
+// InjectFault registers a fault. Faults are matched in registration order
+// and removed once they have failed Times requests.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// FailNext fails the next times requests matching method and path with the
+// given status code
+func (s *Server) FailNext(method, path string, statusCode, times int) {
+	s.InjectFault(Fault{
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+		Times:      times,
+	})
+}
+
+// DelayNext delays the next times requests matching method and path by the
+// given duration before handling them normally
+func (s *Server) DelayNext(method, path string, delay time.Duration, times int) {
+	s.InjectFault(Fault{
+		Method: method,
+		Path:   path,
+		Delay:  delay,
+		Times:  times,
+	})
+}
+
+// ClearFaults removes every injected fault
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// matchFault returns the first fault matching the request and consumes one
+// of its hits. The caller must hold s.mu.
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, fault := range s.faults {
+		if !fault.matches(r) {
+			continue
+		}
+
+		fault.hits++
+		if fault.Times > 0 && fault.hits >= fault.Times {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		return fault
+	}
+	return nil
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(f.Path, "*"); ok {
+		return strings.HasPrefix(r.URL.Path, prefix)
+	}
+	return f.Path == "" || f.Path == r.URL.Path
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	code := f.Code
+	if code == "" {
+		code = defaultErrorCode(f.StatusCode)
+	}
+	if f.RetryAfter != "" {
+		w.Header().Set("Retry-After", f.RetryAfter)
+	}
+	writeError(w, f.StatusCode, code, "injected fault: "+http.StatusText(f.StatusCode), nil)
+}
+
+// defaultErrorCode returns the envelope error code the API uses for a status
+func defaultErrorCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return "VALIDATION_ERROR"
+	case http.StatusUnauthorized:
+		return "UNAUTHORIZED"
+	case http.StatusForbidden:
+		return "FORBIDDEN"
+	case http.StatusNotFound:
+		return "NOT_FOUND"
+	case http.StatusConflict:
+		return "CONFLICT"
+	case http.StatusTooManyRequests:
+		return "RATE_LIMITED"
+	}
+	return "INTERNAL_ERROR"
+}
@@ -0,0 +1,41 @@
+package tierzerotest
+
+import (
+	"net/http"
+
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+func (s *Server) listWebhookSubscriptions(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := client.ListWebhookSubscriptionsResponse{
+		WebhookSubscriptions: append([]client.WebhookSubscription{}, s.webhookSubscriptions...),
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) getDefaultRunbook(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, s.defaultRunbook)
+}
+
+func (s *Server) listNotificationIntegrations(w http.ResponseWriter, r *http.Request) {
+	kind := r.URL.Query().Get("kind")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := client.ListNotificationIntegrationsResponse{
+		NotificationIntegrations: []client.NotificationIntegration{},
+	}
+	for _, integration := range s.notificationIntegrations {
+		if kind == "" || integration.Kind == kind {
+			response.NotificationIntegrations = append(response.NotificationIntegrations, integration)
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
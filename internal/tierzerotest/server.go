@@ -0,0 +1,281 @@
+// Package tierzerotest provides an in-memory fake of the TierZero v1 API for
+// unit and acceptance tests. It implements every endpoint used by the client
+// package, including the quirks of the real API:
+//
+//   - alert responders are soft deleted and keep holding their name until
+//     they are purged, and can be restored until then;
+//   - creating a responder with a duplicate name returns the existing one,
+//     unless an Idempotency-Key is sent, in which case only a retry with the
+//     same key returns it and any other request fails with 409 Conflict;
+//   - the responder URL is only returned by create, update and list;
+//   - runbook prompts that are not set are filled from the default runbook;
+//   - updates follow JSON Merge Patch semantics.
+//
+// Faults (404, 409, 429, 500, ...) and delays can be injected on any endpoint.
+package tierzerotest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tierzero/terraform-provider-tierzero/internal/client"
+)
+
+// DefaultAPIKey is the API key accepted by servers created without WithAPIKey
+const DefaultAPIKey = "tierzerotest-api-key"
+
+// Server is an in-memory fake of the TierZero v1 API
+type Server struct {
+	*httptest.Server
+
+	// APIKey is the only API key accepted by the server
+	APIKey string
+	// PageSize is the default number of alert responders per list page
+	PageSize int
+
+	mu                       sync.Mutex
+	alertResponders          []*storedAlertResponder
+	nextID                   int
+	idempotencyKeys          map[string]string
+	webhookSubscriptions     []client.WebhookSubscription
+	notificationIntegrations []client.NotificationIntegration
+	defaultRunbook           client.Runbook
+	faults                   []*Fault
+	requests                 []Request
+	now                      func() time.Time
+	ignoreCreateStatus       bool
+}
+
+// storedAlertResponder is an alert responder along with server-side state
+type storedAlertResponder struct {
+	client.AlertResponder
+	deleted bool
+}
+
+// Request is a request received by the server, recorded for assertions
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithAPIKey sets the API key accepted by the server
+func WithAPIKey(apiKey string) Option {
+	return func(s *Server) {
+		s.APIKey = apiKey
+	}
+}
+
+// WithPageSize sets the default number of alert responders per list page
+func WithPageSize(pageSize int) Option {
+	return func(s *Server) {
+		s.PageSize = pageSize
+	}
+}
+
+// WithClock overrides the time source used for created_at and updated_at
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// WithoutCreateStatus makes the server ignore the status of create requests
+// and create every alert responder ACTIVE, as API versions that cannot create
+// paused alert responders do
+func WithoutCreateStatus() Option {
+	return func(s *Server) {
+		s.ignoreCreateStatus = true
+	}
+}
+
+// NewServer starts a fake TierZero API server. It is closed automatically
+// when the test completes.
+func NewServer(t testing.TB, opts ...Option) *Server {
+	t.Helper()
+
+	s := &Server{
+		APIKey:          DefaultAPIKey,
+		PageSize:        100,
+		idempotencyKeys: map[string]string{},
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/alert-responders", s.listAlertResponders(false))
+	mux.HandleFunc("GET /api/v1/deleted-alert-responders", s.listAlertResponders(true))
+	mux.HandleFunc("POST /api/v1/alert-responders", s.createAlertResponder)
+	mux.HandleFunc("GET /api/v1/alert-responders/{id}", s.getAlertResponder)
+	mux.HandleFunc("PUT /api/v1/alert-responders/{id}", s.updateAlertResponder)
+	mux.HandleFunc("DELETE /api/v1/alert-responders/{id}", s.deleteAlertResponder)
+	mux.HandleFunc("POST /api/v1/alert-responders/{id}/enable", s.setAlertResponderStatus(statusActive))
+	mux.HandleFunc("POST /api/v1/alert-responders/{id}/disable", s.setAlertResponderStatus(statusPaused))
+	mux.HandleFunc("POST /api/v1/alert-responders/{id}/restore", s.restoreAlertResponder)
+	mux.HandleFunc("GET /api/v1/webhook-subscriptions", s.listWebhookSubscriptions)
+	mux.HandleFunc("GET /api/v1/notification-integrations", s.listNotificationIntegrations)
+	mux.HandleFunc("GET /api/v1/default-runbook", s.getDefaultRunbook)
+
+	s.Server = httptest.NewServer(s.handle(mux))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Client returns an API client configured for the server. Retries wait only
+// a few milliseconds so fault injection tests run quickly.
+func (s *Server) Client() *client.Client {
+	c := client.NewClient(s.URL, s.APIKey)
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = 10 * time.Millisecond
+	return c
+}
+
+// ProviderConfig returns a provider block pointing Terraform at the server.
+// Deletion protection is turned off so that tests can destroy what they create.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "tierzero" {
+  api_key        = %q
+  base_url       = %q
+  retry_max_wait = 1
+
+  deletion_protection = false
+}
+`, s.APIKey, s.URL)
+}
+
+// Requests returns every request received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// handle authenticates requests, records them and applies injected faults
+// before passing them to the API handlers
+func (s *Server) handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "could not read request body", nil)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+			Header: r.Header.Clone(),
+			Body:   body,
+		})
+		authorized := r.Header.Get("X-TierZero-Org-Api-Key") == s.APIKey
+		var fault *Fault
+		if authorized {
+			fault = s.matchFault(r)
+		}
+		s.mu.Unlock()
+
+		if !authorized {
+			writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "invalid API key", nil)
+			return
+		}
+
+		if fault != nil && fault.Delay > 0 {
+			select {
+			case <-time.After(fault.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if fault == nil || fault.StatusCode == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if fault.AfterProcessing {
+			next.ServeHTTP(httptest.NewRecorder(), r)
+		}
+		fault.write(w)
+	})
+}
+
+// AddWebhookSubscription makes a webhook subscription available to alert responders
+func (s *Server) AddWebhookSubscription(sub client.WebhookSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.webhookSubscriptions = append(s.webhookSubscriptions, sub)
+}
+
+// AddNotificationIntegration makes a notification integration available to
+// alert responders. An ID and creation time are assigned when empty.
+func (s *Server) AddNotificationIntegration(integration client.NotificationIntegration) client.NotificationIntegration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if integration.ID == "" {
+		integration.ID = globalID("NotificationIntegration", len(s.notificationIntegrations)+1)
+	}
+	if integration.CreatedAt == "" {
+		integration.CreatedAt = s.timestamp()
+	}
+	s.notificationIntegrations = append(s.notificationIntegrations, integration)
+	return integration
+}
+
+// SetDefaultRunbook sets the organization's default runbook. It only applies
+// to alert responders written afterwards, as with the real API.
+func (s *Server) SetDefaultRunbook(runbook client.Runbook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.defaultRunbook = runbook
+}
+
+// globalID builds an opaque Global ID in the format used by the API
+func globalID(kind string, n int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("GraphQL%s:%d", kind, n)))
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+// writeJSON writes a JSON response body
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error in the API's error envelope
+func writeError(w http.ResponseWriter, statusCode int, code, message string, fieldErrors []client.FieldError) {
+	envelope := map[string]interface{}{
+		"code":       code,
+		"message":    message,
+		"request_id": fmt.Sprintf("req-%d", time.Now().UnixNano()),
+	}
+	if len(fieldErrors) > 0 {
+		envelope["field_errors"] = fieldErrors
+	}
+	writeJSON(w, statusCode, map[string]interface{}{"error": envelope})
+}